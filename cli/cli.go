@@ -5,19 +5,27 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/switchupcb/copygen/cli/config"
 	"github.com/switchupcb/copygen/cli/generator"
 	"github.com/switchupcb/copygen/cli/matcher"
+	"github.com/switchupcb/copygen/cli/models"
 	"github.com/switchupcb/copygen/cli/parser"
 )
 
 // Environment represents the copygen environment.
 type Environment struct {
-	YMLPath string // The .yml file path used as a configuration file.
-	Output  bool   // Whether to print the generated code to stdout.
-	Write   bool   // Whether to write the generated code to a file.
+	YML     *config.YML // The configuration used instead of a .yml file (or nil).
+	Dir     string      // The directory that the relative filepaths of the YML are resolved from.
+	YMLPath string      // The .yml file path used as a configuration file.
+	Pattern string      // The directory pattern used to find .yml configuration files (i.e `./...`).
+	Output  bool        // Whether to print the generated code to stdout.
+	Write   bool        // Whether to write the generated code to a file.
+	Check   bool        // Whether to check that the existing output file is up to date (without writing).
+	Watch   bool        // Whether to generate code again when a watched file changes (using RunWatch).
 }
 
 // CLI runs copygen from a Command Line Interface and returns the exit status.
@@ -29,7 +37,16 @@ func CLI() int {
 		return 2
 	}
 
-	if _, err := env.Run(); err != nil {
+	if env.Watch {
+		if err := env.RunWatch(nil); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+
+		return 0
+	}
+
+	if err := env.runOnce(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return 1
 	}
@@ -43,26 +60,125 @@ func (e *Environment) parseArgs() error {
 	var (
 		ymlpath = flag.String("yml", "", "The path to the .yml flag used for code generation (from the current working directory).")
 		output  = flag.Bool("o", false, "Use -o to print generated code to the screen.")
+		check   = flag.Bool("check", false, "Use -check to print a diff and fail when the output file is out of date (without writing).")
+		watch   = flag.Bool("watch", false, "Use -watch to generate code again when the setup, template, .yml, or imported Go files change.")
+
+		// configuration flags are used instead of a .yml file.
+		setup       = flag.String("setup", "", "The path to the setup file used for code generation (instead of a .yml file).")
+		outpath     = flag.String("output", "", "The path to the output file used for code generation (instead of a .yml file).")
+		template    = flag.String("template", "", "The path to the optional template file used for code generation (instead of a .yml file).")
+		nilPolicy   = flag.String("nil", "", "The nil policy of generated functions: skip, zero, or error (instead of a .yml file).")
+		constructor = flag.Bool("constructor", false, "Use -constructor to generate functions that return new to-types (instead of a .yml file).")
+
+		strict            = flag.Bool("strict", false, "Use -strict to report unused options and unknown option categories as errors (instead of a .yml file).")
+		custom            = flag.String("custom", "", "The comma-separated custom option categories that are allowed in strict mode (instead of a .yml file).")
+		skip              = flag.Bool("skip", false, "Use -skip to skip the matcher (instead of a .yml file).")
+		exhaustive        = flag.Bool("exhaustive", false, "Use -exhaustive to require every to-field to be matched or ignored (instead of a .yml file).")
+		cast              = flag.Bool("cast", false, "Use -cast to enable automatic casting (instead of a .yml file).")
+		castDepth         = flag.Int("cast-depth", 1, "The maximum depth for automatic casting, where 0 disables it (instead of a .yml file).")
+		castDisableAssign = flag.Bool("cast-disable-assign", false, "Use -cast-disable-assign to disable the assignment of objects to interfaces (instead of a .yml file).")
+		castDisableAssert = flag.Bool("cast-disable-assert", false, "Use -cast-disable-assert to disable the assertion of interfaces to objects (instead of a .yml file).")
+		castDisableConv   = flag.Bool("cast-disable-convert", false, "Use -cast-disable-convert to disable type conversion (instead of a .yml file).")
 	)
 
 	// parse the command line arguments.
 	flag.Parse()
 
-	if !strings.HasSuffix(*ymlpath, ".yml") {
-		return errors.New("you must specify a .yml configuration file using -yml")
+	// determine whether configuration flags are used.
+	var configured bool
+	var depth *int
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "yml", "o", "check", "watch":
+		case "cast-depth":
+			depth = castDepth
+			configured = true
+		default:
+			configured = true
+		}
+	})
+
+	switch {
+	case configured:
+		if *ymlpath != "" || flag.NArg() != 0 {
+			return errors.New("you must specify a .yml configuration file using -yml, a pattern, or configuration flags (i.e -setup), but not more than one")
+		}
+
+		if *setup == "" || *outpath == "" {
+			return errors.New("you must specify a setup file using -setup and an output file using -output")
+		}
+
+		e.YML = &config.YML{
+			Generated: config.Generated{
+				Setup:       *setup,
+				Output:      *outpath,
+				Template:    *template,
+				Nil:         *nilPolicy,
+				Constructor: *constructor,
+			},
+			Parser: config.Parser{
+				Custom: splitList(*custom),
+				Strict: *strict,
+			},
+			Matcher: config.Matcher{
+				Skip:       *skip,
+				Exhaustive: *exhaustive,
+				Cast: config.Cast{
+					Enabled: *cast,
+					Depth:   depth,
+					Disabled: config.Disabled{
+						AssignObjectInterface: *castDisableAssign,
+						AssertInterfaceObject: *castDisableAssert,
+						Convert:               *castDisableConv,
+					},
+				},
+			},
+		}
+
+		// relative filepaths are resolved from the directory of the file that contains
+		// the `//go:generate` directive (or the current working directory).
+		e.Dir = filepath.Dir(os.Getenv("GOFILE"))
+
+	case flag.NArg() > 1:
+		return errors.New("you must specify one pattern (i.e `copygen ./...`)")
+
+	case flag.NArg() == 1:
+		if *ymlpath != "" {
+			return errors.New("you must specify a .yml configuration file using -yml or a pattern, but not both")
+		}
+
+		e.Pattern = flag.Arg(0)
+
+	case !strings.HasSuffix(*ymlpath, ".yml"):
+		return errors.New("you must specify a .yml configuration file using -yml, a pattern (i.e `copygen ./...`), or configuration flags (i.e -setup)")
+	}
+
+	if *check && *watch {
+		return errors.New("you must specify -check or -watch, but not both")
 	}
 
 	e.YMLPath = *ymlpath
 	e.Output = *output
-	e.Write = true
+	e.Check = *check
+	e.Watch = *watch
+	e.Write = !e.Check
 
 	return nil
 }
 
-// Run runs copygen programmatically using the given Environment's YMLPath.
+// splitList splits a comma-separated list (or returns nil for an empty list).
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+
+	return strings.Split(list, ",")
+}
+
+// Run runs copygen programmatically using the given Environment's YML (or YMLPath).
 func (e *Environment) Run() (string, error) {
 	// The configuration file is loaded (.yml)
-	gen, err := config.LoadYML(e.YMLPath)
+	gen, err := e.loadGenerator()
 	if err != nil {
 		return "", fmt.Errorf("%w", err)
 	}
@@ -72,13 +188,109 @@ func (e *Environment) Run() (string, error) {
 		return "", fmt.Errorf("%w", err)
 	}
 
+	return e.generate(gen)
+}
+
+// loadGenerator loads a generator using the given Environment's YML (or YMLPath).
+func (e *Environment) loadGenerator() (*models.Generator, error) {
+	if e.YML != nil {
+		return config.NewGenerator(*e.YML, e.Dir)
+	}
+
+	return config.LoadYML(e.YMLPath)
+}
+
+// RunPattern runs copygen programmatically using the .yml configuration files
+// found with the given Environment's Pattern.
+//
+// The packages of every setup file are loaded once, then code is generated for
+// each configuration file concurrently. The errors of every configuration file are combined.
+func (e *Environment) RunPattern() ([]string, error) {
+	ymlpaths, err := config.FindYML(e.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	if len(ymlpaths) == 0 {
+		return nil, fmt.Errorf("no .yml configuration files were found using the pattern: %v", e.Pattern)
+	}
+
+	// The configuration files are loaded (.yml)
+	var errs []error
+	gens := make([]*models.Generator, 0, len(ymlpaths))
+	genpaths := make([]string, 0, len(ymlpaths))
+	for _, ymlpath := range ymlpaths {
+		gen, err := config.LoadYML(ymlpath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", ymlpath, err))
+			continue
+		}
+
+		gens = append(gens, gen)
+		genpaths = append(genpaths, ymlpath)
+	}
+
+	if len(gens) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// The packages of the data files are loaded once.
+	pkgs, err := parser.LoadPackages(gens...)
+	if err != nil {
+		return nil, errors.Join(append(errs, err)...)
+	}
+
+	// The data files are parsed (.go), then used to generate code concurrently.
+	var wg sync.WaitGroup
+	codes := make([]string, len(gens))
+	generrs := make([]error, len(gens))
+	for i := range gens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if err := parser.ParsePackage(gens[i], pkgs[gens[i].Setpath], pkgs[gens[i].Outpath]); err != nil {
+				generrs[i] = fmt.Errorf("%v: %w", genpaths[i], err)
+				return
+			}
+
+			code, err := e.generate(gens[i])
+			if err != nil {
+				generrs[i] = fmt.Errorf("%v: %w", genpaths[i], err)
+			}
+
+			codes[i] = code
+		}(i)
+	}
+
+	wg.Wait()
+
+	return codes, errors.Join(append(errs, generrs...)...)
+}
+
+// generate matches the fields of a parsed generator, then generates code.
+func (e *Environment) generate(gen *models.Generator) (string, error) {
 	// The matcher is run on the parsed data (to create the objects used during generation).
 	if !gen.Options.Matcher.Skip {
-		if err = matcher.Match(gen); err != nil {
+		if err := matcher.Match(gen); err != nil {
 			return "", fmt.Errorf("%w", err)
 		}
 	}
 
+	// The generator is used to check the existing output file.
+	if e.Check {
+		diff, err := generator.Check(gen)
+		if err != nil {
+			return "", fmt.Errorf("%w", err)
+		}
+
+		if diff != "" {
+			return "", fmt.Errorf("the output file is out of date: %v\n%v", gen.Outpath, diff)
+		}
+
+		return "", nil
+	}
+
 	// The generator is used to generate code.
 	code, err := generator.Generate(gen, e.Output, e.Write)
 	if err != nil {
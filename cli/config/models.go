@@ -5,25 +5,46 @@ package config
 type YML struct {
 	Options   map[string]interface{} `yaml:"custom"`
 	Generated Generated              `yaml:"generated"`
+	Parser    Parser                 `yaml:"parser"`
 	Matcher   Matcher                `yaml:"matcher"`
 }
 
 // Generated represents generated properties of the YML file.
 type Generated struct {
-	Setup    string `yaml:"setup"`
-	Output   string `yaml:"output"`
-	Template string `yaml:"template"`
+	Setup       string `yaml:"setup"`
+	Output      string `yaml:"output"`
+	Template    string `yaml:"template"`
+	Nil         string `yaml:"nil"`
+	Constructor bool   `yaml:"constructor"`
+}
+
+// Parser represents parser properties of the YML file.
+type Parser struct {
+	Custom []string `yaml:"custom"`
+	Strict bool     `yaml:"strict"`
 }
 
 // Matcher represents matcher properties of the YML file.
 type Matcher struct {
-	Skip bool `yaml:"skip"`
-	Cast Cast `yaml:"cast"`
+	Skip       bool   `yaml:"skip"`
+	Exhaustive bool   `yaml:"exhaustive"`
+	Cast       Cast   `yaml:"cast"`
+	Naming     Naming `yaml:"naming"`
+}
+
+// Naming represents matcher naming properties of the YML file.
+type Naming struct {
+	Insensitive bool     `yaml:"insensitive"`
+	Snake       bool     `yaml:"snake"`
+	Flatten     bool     `yaml:"flatten"`
+	Tag         string   `yaml:"tag"`
+	Prefixes    []string `yaml:"prefixes"`
+	Suffixes    []string `yaml:"suffixes"`
 }
 
 // Cast represents matcher cast properties of the YML file.
 type Cast struct {
-	Depth    int      `yaml:"depth"`
+	Depth    *int     `yaml:"depth"` // The maximum depth for automatic casting (or nil to use the default depth).
 	Enabled  bool     `yaml:"enabled"`
 	Disabled Disabled `yaml:"disabled"`
 }
@@ -3,8 +3,10 @@ package config
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/switchupcb/copygen/cli/models"
 	"gopkg.in/yaml.v3"
@@ -22,44 +24,171 @@ func LoadYML(relativepath string) (*models.Generator, error) {
 		return nil, fmt.Errorf("an error occurred unmarshalling the .yml file\n%w", err)
 	}
 
-	gen := ParseYML(yml)
-
 	// determine the actual filepath of the loader.
 	absloadpath, err := filepath.Abs(relativepath)
 	if err != nil {
 		return nil, fmt.Errorf("an error occurred while determining the absolute file path of the loader file\n%v", relativepath)
 	}
 
+	return NewGenerator(yml, filepath.Dir(absloadpath))
+}
+
+// NewGenerator parses a YML into a Generator with filepaths that are relative to a directory.
+func NewGenerator(yml YML, dir string) (*models.Generator, error) {
+	absdir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("an error occurred while determining the absolute file path of the directory\n%v", dir)
+	}
+
+	gen := ParseYML(yml)
+	if !models.IsNilPolicy(gen.Options.Nil) {
+		return nil, fmt.Errorf("the nil policy %q is not %q, %q, or %q", gen.Options.Nil, models.NilSkip, models.NilZero, models.NilError)
+	}
+
+	// a cast depth of 0 disables automatic casting.
+	if gen.Options.Matcher.CastDepth < 0 {
+		return nil, fmt.Errorf("the cast depth %d is negative", gen.Options.Matcher.CastDepth)
+	}
+
 	// determine the actual filepath of the setup.go file.
-	gen.Setpath = filepath.Join(filepath.Dir(absloadpath), gen.Setpath)
+	gen.Setpath = resolvePath(absdir, gen.Setpath)
 
 	// determine the actual filepath of the template file (if provided).
 	if gen.Tempath != "" {
-		gen.Tempath = filepath.Join(filepath.Dir(absloadpath), gen.Tempath)
+		gen.Tempath = resolvePath(absdir, gen.Tempath)
 	}
 
 	// determine the actual filepath of the output file.
-	gen.Outpath = filepath.Join(filepath.Dir(absloadpath), gen.Outpath)
+	gen.Outpath = resolvePath(absdir, gen.Outpath)
 
 	return gen, nil
 }
 
+// resolvePath returns the absolute filepath of a path that is relative to a directory (or absolute).
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+// PatternRecursive represents the suffix of a pattern that matches a directory and its subdirectories.
+const PatternRecursive = "/..."
+
+// FindYML finds the .yml configuration files in the directory of a pattern (i.e `./...`).
+//
+// A pattern that ends in `/...` matches the directory and its subdirectories (except for
+// `testdata` directories and directories that begin with `.` or `_`, like the go command).
+// A .yml file is a configuration file when it specifies a setup file (`generated: setup`).
+func FindYML(pattern string) ([]string, error) {
+	root := strings.TrimSuffix(filepath.ToSlash(pattern), PatternRecursive)
+	recursive := root != filepath.ToSlash(pattern)
+	if root == "" {
+		root = "."
+	}
+
+	root = filepath.FromSlash(root)
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("the specified pattern directory doesn't exist: %v\n%w", pattern, err)
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("the specified pattern is not a directory: %v", pattern)
+	}
+
+	var ymlpaths []string
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path == root {
+				return nil
+			}
+
+			name := d.Name()
+			if !recursive || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) == ".yml" && isConfigYML(path) {
+			ymlpaths = append(ymlpaths, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("an error occurred while finding the .yml files of the pattern: %v\n%w", pattern, err)
+	}
+
+	return ymlpaths, nil
+}
+
+// isConfigYML determines whether a .yml file is a configuration file.
+func isConfigYML(path string) bool {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	var yml YML
+	if err := yaml.Unmarshal(file, &yml); err != nil {
+		return false
+	}
+
+	return yml.Generated.Setup != ""
+}
+
+// defaultCastDepth represents the default maximum depth for automatic casting.
+const defaultCastDepth = 1
+
 // ParseYML parses a YML into a Generator.
 func ParseYML(yml YML) *models.Generator {
+	castDepth := defaultCastDepth
+	if yml.Matcher.Cast.Depth != nil {
+		castDepth = *yml.Matcher.Cast.Depth
+	}
+
+	if yml.Generated.Nil == "" {
+		yml.Generated.Nil = models.NilSkip
+	}
+
 	return &models.Generator{
 		Setpath: yml.Generated.Setup,
 		Outpath: yml.Generated.Output,
 		Tempath: yml.Generated.Template,
 		Options: models.GeneratorOptions{
+			Parser: models.ParserOptions{
+				Custom: yml.Parser.Custom,
+				Strict: yml.Parser.Strict,
+			},
 			Matcher: models.MatcherOptions{
 				Skip:                         yml.Matcher.Skip,
+				Exhaustive:                   yml.Matcher.Exhaustive,
 				AutoCast:                     yml.Matcher.Cast.Enabled,
-				CastDepth:                    yml.Matcher.Cast.Depth,
+				CastDepth:                    castDepth,
 				DisableAssignObjectInterface: yml.Matcher.Cast.Disabled.AssignObjectInterface,
 				DisableAssertInterfaceObject: yml.Matcher.Cast.Disabled.AssertInterfaceObject,
 				DisableConvert:               yml.Matcher.Cast.Disabled.Convert,
+				Naming: models.NamingOptions{
+					Insensitive: yml.Matcher.Naming.Insensitive,
+					Snake:       yml.Matcher.Naming.Snake,
+					Flatten:     yml.Matcher.Naming.Flatten,
+					Tag:         yml.Matcher.Naming.Tag,
+					Prefixes:    yml.Matcher.Naming.Prefixes,
+					Suffixes:    yml.Matcher.Naming.Suffixes,
+				},
 			},
-			Custom: yml.Options,
+			Custom:      yml.Options,
+			Nil:         yml.Generated.Nil,
+			Constructor: yml.Generated.Constructor,
 		},
 	}
 }
@@ -0,0 +1,226 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContext represents the amount of unchanged lines that surround the changes of a hunk.
+	diffContext = 3
+
+	// diffMaxCells represents the maximum size of the table used to find the longest common subsequence of lines.
+	// Larger differences are represented as a replacement of every changed line.
+	diffMaxCells = 1 << 22
+)
+
+// edit represents an operation that transforms the lines of one file into the lines of another.
+type edit struct {
+	op   byte // ' ' (equal), '-' (delete), or '+' (insert).
+	line string
+}
+
+// unifiedDiff returns the unified diff of two files (or "" when the files are equal).
+func unifiedDiff(oldname, newname string, olddata, newdata []byte) string {
+	if string(olddata) == string(newdata) {
+		return ""
+	}
+
+	edits := diffLines(splitLines(string(olddata)), splitLines(string(newdata)))
+
+	var diff strings.Builder
+	diff.WriteString("--- " + oldname + "\n")
+	diff.WriteString("+++ " + newname + "\n")
+
+	// oldline and newline represent the line numbers (from 0) of the current edit.
+	oldline, newline := 0, 0
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			oldline++
+			newline++
+			i++
+			continue
+		}
+
+		// determine the edits of the hunk, including its surrounding context.
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		end := i
+		for end < len(edits) {
+			if edits[end].op != ' ' {
+				end++
+				continue
+			}
+
+			// determine whether the next change is close enough to be part of this hunk.
+			next := end
+			for next < len(edits) && edits[next].op == ' ' {
+				next++
+			}
+
+			if next == len(edits) || next-end > 2*diffContext {
+				break
+			}
+
+			end = next
+		}
+
+		stop := end + diffContext
+		if stop > len(edits) {
+			stop = len(edits)
+		}
+
+		// determine the range of the hunk.
+		oldstart, newstart := oldline-(i-start), newline-(i-start)
+		var oldcount, newcount int
+		for _, e := range edits[start:stop] {
+			if e.op != '+' {
+				oldcount++
+			}
+
+			if e.op != '-' {
+				newcount++
+			}
+		}
+
+		diff.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(oldstart, oldcount), hunkRange(newstart, newcount)))
+		for _, e := range edits[start:stop] {
+			diff.WriteString(string(e.op) + e.line + "\n")
+		}
+
+		// advance past the hunk.
+		for _, e := range edits[i:stop] {
+			if e.op != '+' {
+				oldline++
+			}
+
+			if e.op != '-' {
+				newline++
+			}
+		}
+
+		i = stop
+	}
+
+	return diff.String()
+}
+
+// hunkRange returns the range of a hunk in unified diff format (with line numbers from 1).
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// noNewline represents the marker of a line that is not followed by a newline at the end of a file.
+const noNewline = "\n\\ No newline at end of file"
+
+// splitLines splits a file into lines.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	if !strings.HasSuffix(s, "\n") {
+		lines[len(lines)-1] += noNewline
+	}
+
+	return lines
+}
+
+// diffLines returns the edits that transform the old lines into the new lines.
+func diffLines(oldlines, newlines []string) []edit {
+	// common lines at the start and end of each file are unchanged.
+	prefix := 0
+	for prefix < len(oldlines) && prefix < len(newlines) && oldlines[prefix] == newlines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldlines)-prefix && suffix < len(newlines)-prefix &&
+		oldlines[len(oldlines)-1-suffix] == newlines[len(newlines)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]edit, 0, len(oldlines)+len(newlines))
+	for _, line := range oldlines[:prefix] {
+		edits = append(edits, edit{op: ' ', line: line})
+	}
+
+	a, b := oldlines[prefix:len(oldlines)-suffix], newlines[prefix:len(newlines)-suffix]
+	if (len(a)+1)*(len(b)+1) > diffMaxCells {
+		for _, line := range a {
+			edits = append(edits, edit{op: '-', line: line})
+		}
+
+		for _, line := range b {
+			edits = append(edits, edit{op: '+', line: line})
+		}
+	} else {
+		edits = append(edits, lcsEdits(a, b)...)
+	}
+
+	for _, line := range oldlines[len(oldlines)-suffix:] {
+		edits = append(edits, edit{op: ' ', line: line})
+	}
+
+	return edits
+}
+
+// lcsEdits returns the edits that transform the lines a into the lines b
+// using the longest common subsequence of lines.
+func lcsEdits(a, b []string) []edit {
+	// lcs[i][j] represents the length of the longest common subsequence of a[i:] and b[j:].
+	width := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else if lcs[(i+1)*width+j] >= lcs[i*width+j+1] {
+				lcs[i*width+j] = lcs[(i+1)*width+j]
+			} else {
+				lcs[i*width+j] = lcs[i*width+j+1]
+			}
+		}
+	}
+
+	edits := make([]edit, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, edit{op: ' ', line: a[i]})
+			i++
+			j++
+
+		case lcs[(i+1)*width+j] >= lcs[i*width+j+1]:
+			edits = append(edits, edit{op: '-', line: a[i]})
+			i++
+
+		default:
+			edits = append(edits, edit{op: '+', line: b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		edits = append(edits, edit{op: '-', line: a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		edits = append(edits, edit{op: '+', line: b[j]})
+	}
+
+	return edits
+}
@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// numberedLines returns a file with the numbered lines from 1 to n,
+// replacing the lines of the given numbers.
+func numberedLines(n int, replaced map[int]string) string {
+	var lines strings.Builder
+	for i := 1; i <= n; i++ {
+		if line, ok := replaced[i]; ok {
+			lines.WriteString(line + "\n")
+			continue
+		}
+
+		lines.WriteString(strconv.Itoa(i) + "\n")
+	}
+
+	return lines.String()
+}
+
+// TestUnifiedDiff tests the hunks of unified diffs, which are merged when their changes are close.
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want string
+	}{
+		{
+			name: "equal",
+			old:  numberedLines(10, nil),
+			new:  numberedLines(10, nil),
+			want: "",
+		},
+		{
+			name: "single change",
+			old:  numberedLines(10, nil),
+			new:  numberedLines(10, map[int]string{5: "five"}),
+			want: "--- a\n+++ b\n" +
+				"@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			name: "merged hunks",
+			old:  numberedLines(20, nil),
+			new:  numberedLines(20, map[int]string{5: "five", 11: "eleven"}),
+			want: "--- a\n+++ b\n" +
+				"@@ -2,13 +2,13 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n 9\n 10\n-11\n+eleven\n 12\n 13\n 14\n",
+		},
+		{
+			name: "separate hunks",
+			old:  numberedLines(20, nil),
+			new:  numberedLines(20, map[int]string{5: "five", 15: "fifteen"}),
+			want: "--- a\n+++ b\n" +
+				"@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n" +
+				"@@ -12,7 +12,7 @@\n 12\n 13\n 14\n-15\n+fifteen\n 16\n 17\n 18\n",
+		},
+		{
+			name: "insert only",
+			old:  "",
+			new:  "a\nb\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "no newline at end of file",
+			old:  "a\nb",
+			new:  "a\nb\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+	}
+
+	for _, test := range tests {
+		if got := unifiedDiff("a", "b", []byte(test.old), []byte(test.new)); got != test.want {
+			t.Fatalf("unifiedDiff(%q) got diff\n%v\nwant diff\n%v", test.name, got, test.want)
+		}
+	}
+}
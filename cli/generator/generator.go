@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"go/format"
+	"io/fs"
 	"os"
 	"path/filepath"
 	tmpl "text/template"
@@ -67,6 +68,27 @@ func Generate(gen *models.Generator, output bool, write bool) (string, error) {
 	return code, nil
 }
 
+// Check generates code, then compares it to the existing file at the generator's output path.
+//
+// Check returns a unified diff of the existing file and the generated code
+// (or "" when the existing file is up to date). Check never writes a file.
+func Check(gen *models.Generator) (string, error) {
+	code, err := Generate(gen, false, false)
+	if err != nil {
+		return "", fmt.Errorf("%w", err)
+	}
+
+	existing, err := os.ReadFile(gen.Outpath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("an error occurred reading the existing output file.\n%w", err)
+	}
+
+	// line breaks are normalized, such that a file checked out with CRLF line breaks is up to date.
+	existing = bytes.ReplaceAll(existing, []byte("\r\n"), []byte("\n"))
+
+	return unifiedDiff(gen.Outpath, gen.Outpath+" (generated)", existing, []byte(code)), nil
+}
+
 // generate determines the method of code generation to use,
 // then generates the code.
 func generate(gen *models.Generator) (string, error) {
@@ -3,11 +3,12 @@
 package extract
 
 import (
+	"go/constant"
+	"go/token"
 	"reflect"
-	
+
 	"github.com/switchupcb/copygen/cli/models"
 	"github.com/switchupcb/copygen/cli/models/debug"
-
 )
 
 func init() {
@@ -19,7 +20,27 @@ func init() {
 		"FunctionOptions":  reflect.ValueOf((*models.FunctionOptions)(nil)),
 		"Generator":        reflect.ValueOf((*models.Generator)(nil)),
 		"GeneratorOptions": reflect.ValueOf((*models.GeneratorOptions)(nil)),
+		"MatcherOptions":   reflect.ValueOf((*models.MatcherOptions)(nil)),
+		"NamingOptions":    reflect.ValueOf((*models.NamingOptions)(nil)),
+		"ParserOptions":    reflect.ValueOf((*models.ParserOptions)(nil)),
 		"Type":             reflect.ValueOf((*models.Type)(nil)),
+		"TypeParam":        reflect.ValueOf((*models.TypeParam)(nil)),
+
+		// function, constant and variable definitions
+		"CastModifierFunction": reflect.ValueOf(constant.MakeFromLiteral("\"()\"", token.STRING, 0)),
+		"CastModifierProperty": reflect.ValueOf(constant.MakeFromLiteral("\".\"", token.STRING, 0)),
+		"CollectionChan":       reflect.ValueOf(constant.MakeFromLiteral("\"chan\"", token.STRING, 0)),
+		"CollectionFunc":       reflect.ValueOf(constant.MakeFromLiteral("\"func\"", token.STRING, 0)),
+		"CollectionInterface":  reflect.ValueOf(constant.MakeFromLiteral("\"interface\"", token.STRING, 0)),
+		"CollectionMap":        reflect.ValueOf(constant.MakeFromLiteral("\"map\"", token.STRING, 0)),
+		"CollectionPointer":    reflect.ValueOf(constant.MakeFromLiteral("\"*\"", token.STRING, 0)),
+		"CollectionSlice":      reflect.ValueOf(constant.MakeFromLiteral("\"[]\"", token.STRING, 0)),
+		"IsCastFunction":       reflect.ValueOf(models.IsCastFunction),
+		"IsCastProperty":       reflect.ValueOf(models.IsCastProperty),
+		"IsNilPolicy":          reflect.ValueOf(models.IsNilPolicy),
+		"NilError":             reflect.ValueOf(constant.MakeFromLiteral("\"error\"", token.STRING, 0)),
+		"NilSkip":              reflect.ValueOf(constant.MakeFromLiteral("\"skip\"", token.STRING, 0)),
+		"NilZero":              reflect.ValueOf(constant.MakeFromLiteral("\"zero\"", token.STRING, 0)),
 	}
 
 	Symbols["github.com/switchupcb/copygen/cli/models/models/debug"] = map[string]reflect.Value{
@@ -4,7 +4,9 @@
 package template
 
 import (
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/switchupcb/copygen/cli/models"
 )
@@ -61,14 +63,44 @@ func generateComment(function *models.Function) string {
 
 // generateSignature generates a function's signature.
 func generateSignature(function *models.Function) string {
-	return "func " + function.Name + "(" + generateParameters(function) + ") {"
+	return "func " + function.Name + generateTypeParameters(function) + "(" + generateParameters(function) + ")" + generateResults(function) + " {"
+}
+
+// generateTypeParameters generates the type parameters of a generic function.
+func generateTypeParameters(function *models.Function) string {
+	if len(function.TypeParams) == 0 {
+		return ""
+	}
+
+	var parameters strings.Builder
+	parameters.WriteString("[")
+	for i, typeParam := range function.TypeParams {
+		if i+1 == len(function.TypeParams) {
+			parameters.WriteString(typeParam.Name + " " + typeParam.Constraint)
+			break
+		}
+
+		parameters.WriteString(typeParam.Name + " " + typeParam.Constraint + ", ")
+	}
+	parameters.WriteString("]")
+
+	return parameters.String()
 }
 
 // generateParameters generates the parameters of a function.
+//
+// A constructor doesn't use to-type parameters, since it returns new to-types.
+// A context is the first parameter of a function that accepts one.
 func generateParameters(function *models.Function) string {
 	var parameters strings.Builder
-	for _, toType := range function.To {
-		parameters.WriteString(toType.Field.VariableName + " " + toType.Name() + ", ")
+	if function.Context {
+		parameters.WriteString(contextParameter + " " + function.ContextDefinition + ", ")
+	}
+
+	if !function.Options.Constructor {
+		for _, toType := range function.To {
+			parameters.WriteString(toType.Field.VariableName + " " + toType.Name() + ", ")
+		}
 	}
 
 	for i, fromType := range function.From {
@@ -83,13 +115,36 @@ func generateParameters(function *models.Function) string {
 	return parameters.String()
 }
 
+// generateResults generates the results of a function (or "").
+func generateResults(function *models.Function) string {
+	var results []string
+	if function.Options.Constructor {
+		for _, toType := range function.To {
+			results = append(results, toType.Name())
+		}
+	}
+
+	if function.ReturnsError() {
+		results = append(results, "error")
+	}
+
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	}
+
+	return " (" + strings.Join(results, ", ") + ")"
+}
+
 // generateBody generates the body of a function.
 func generateBody(function *models.Function) string {
 	var body strings.Builder
 
 	// Assign fields to ToType(s).
 	for i, toType := range function.To {
-		body.WriteString(generateAssignment(toType))
+		body.WriteString(generateAssignment(function, toType))
 		if i+1 != len(function.To) {
 			body.WriteString("\n")
 		}
@@ -99,36 +154,932 @@ func generateBody(function *models.Function) string {
 }
 
 // generateAssignment generates assignments for a to-type.
-func generateAssignment(toType models.Type) string {
+func generateAssignment(function *models.Function, toType models.Type) string {
 	var assign strings.Builder
 	assign.WriteString("// " + toType.Name() + " fields\n")
 
-	for _, toField := range toType.Field.AllFields(nil, nil) {
+	// a constructor declares a new to-type.
+	if function.Options.Constructor {
+		if literal := generateLiteral(function, toType); literal != "" {
+			assign.WriteString(literal)
+			return assign.String()
+		}
+
+		assign.WriteString(generateDeclaration(toType))
+	}
+
+	assign.WriteString(generateFields(function, toType.Field.AllFields(nil, nil), 0))
+
+	return assign.String()
+}
+
+// generateDeclaration generates the statement used to declare a new to-type.
+func generateDeclaration(toType models.Type) string {
+	if toType.Field.IsPointer() {
+		return toType.Field.VariableName + " := new(" + toType.Name()[1:] + ")\n"
+	}
+
+	return "var " + toType.Field.VariableName + " " + toType.Name() + "\n"
+}
+
+// generateLiteral generates the statement used to declare a new to-type using a composite literal (or "")
+// when each of its matched fields is a field of the to-type that's assigned using an expression
+// (i.e `tA := &domain.Account{ID: fA.ID}`).
+func generateLiteral(function *models.Function, toType models.Type) string {
+	typ := toType.Field
+	if typ.IsPointer() {
+		typ = typ.Elem
+	}
+
+	if typ == nil || !typ.IsStruct() || toType.Field.From != nil {
+		return ""
+	}
+
+	var fields strings.Builder
+	for _, toField := range toType.Field.AllFields(nil, nil)[1:] {
+		if toField.From == nil {
+			continue
+		}
+
+		if toField.Parent != toType.Field || len(nilPointers(function, toField, toField.From)) != 0 {
+			return ""
+		}
+
+		expression := generateExpression(function, toField)
+		if expression == "" {
+			return ""
+		}
+
+		fields.WriteString(toField.Name + ": " + expression + ",\n")
+	}
+
+	if toType.Field.IsPointer() {
+		return toType.Field.VariableName + " := &" + toType.Name()[1:] + "{\n" + fields.String() + "}\n"
+	}
+
+	return toType.Field.VariableName + " := " + toType.Name() + "{\n" + fields.String() + "}\n"
+}
+
+// generateFields generates the statements used to assign the matched to-fields of a to-type (or element).
+//
+// The level represents the depth of copied collection elements, which is used to name loop variables.
+func generateFields(function *models.Function, toFields []*models.Field, level int) string {
+	var matched []*models.Field
+	for _, toField := range toFields {
 		if toField.From != nil {
-			assign.WriteString(toField.FullVariableName("") + " = ")
-
-			fromField := toField.From
-			if fromField.Options.Convert != "" {
-				assign.WriteString(fromField.Options.Convert + "(" + fromField.FullVariableName("") + ")\n")
-			} else if fromField.Options.Cast != "" {
-				assign.WriteString(fromField.FullVariableName("") + "." + fromField.Options.Cast + "\n")
-			} else {
-				switch {
-				case toField.FullDefinition() == fromField.FullDefinition():
-					assign.WriteString(fromField.FullVariableName("") + "\n")
-				case toField.FullDefinition()[1:] == fromField.FullDefinition():
-					assign.WriteString("&" + fromField.FullVariableName("") + "\n")
-				case toField.FullDefinition() == fromField.FullDefinition()[1:]:
-					assign.WriteString("*" + fromField.FullVariableName("") + "\n")
-				}
-			}
+			matched = append(matched, toField)
+		}
+	}
+
+	var assign strings.Builder
+	allocated := make(map[*models.Field]bool)
+	for i := 0; i < len(matched); {
+		pointers := nilPointers(function, matched[i], matched[i].From)
+
+		// sibling fields that dereference the same pointers (i.e `fU.Address.City` and `fU.Address.Zip`) share a nil guard.
+		j := i + 1
+		for len(pointers) != 0 && j < len(matched) && samePointers(pointers, nilPointers(function, matched[j], matched[j].From)) {
+			j++
 		}
+
+		assign.WriteString(generateGuardedFields(function, matched[i:j], pointers, allocated, level))
+		i = j
 	}
 
 	return assign.String()
 }
 
+// samePointers determines whether two lists of pointers contain the same pointers (in order).
+func samePointers(a, b []*models.Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateGuardedFields generates the statements used to assign matched to-fields
+// that dereference the same pointers (if any) using one nil guard.
+func generateGuardedFields(function *models.Function, toFields, pointers []*models.Field, allocated map[*models.Field]bool, level int) string {
+	// nested fields (i.e `tA.User.UserID = fB.UserID`) are assigned to allocated parents,
+	// which are only allocated when the from-fields are assigned.
+	//
+	// a parent that's allocated in the body of a nil guard isn't allocated for the fields after it.
+	guardedAllocated := allocated
+	if len(pointers) != 0 && function.Options.Nil != models.NilError {
+		guardedAllocated = make(map[*models.Field]bool, len(allocated))
+		for parent := range allocated {
+			guardedAllocated[parent] = true
+		}
+	}
+
+	var assignment strings.Builder
+	for _, toField := range toFields {
+		parents := unallocatedParents(toField, guardedAllocated)
+		for _, parent := range parents {
+			guardedAllocated[parent] = true
+		}
+
+		assignment.WriteString(generateAllocation(parents))
+		assignment.WriteString(generateFieldAssignment(function, toField, level))
+	}
+
+	return generateNilGuard(function, toFields, pointers, nilErrors(pointers), allocated, assignment.String())
+}
+
+// generateFieldAssignment generates the statements used to assign a from-field to a matched to-field.
+func generateFieldAssignment(function *models.Function, toField *models.Field, level int) string {
+	fromField := toField.From
+
+	if toField.MatchedElem != nil {
+		return generateElements(function, toField, fromField, level)
+	}
+
+	if toField.Copier != nil {
+		return generateCopier(function, toField, fromField)
+	}
+
+	if converter := generateConverter(function, toField, fromField, level); converter != "" {
+		return converter
+	}
+
+	if deepcopy := generateDeepcopy(toField, fromField, level); deepcopy != "" {
+		return deepcopy
+	}
+
+	return toField.FullVariableName("") + " = " + generateExpression(function, toField) + "\n"
+}
+
+// generateExpression generates the expression used to assign a from-field to a matched to-field (or "")
+// when the from-field isn't copied using statements (i.e loops).
+func generateExpression(function *models.Function, toField *models.Field) string {
+	fromField := toField.From
+	if toField.MatchedElem != nil || toField.Copier != nil {
+		return ""
+	}
+
+	if fromField.Options.Convert != "" && !fromField.Options.ConvertError {
+		return fromField.Options.Convert + "(" + contextArgument(fromField.Options.ConvertContext) + fromField.FullVariableName("") + ")"
+	}
+
+	if converter := function.Converter(toField, fromField); converter != nil && !converter.IsFallibleFunc() {
+		return converter.VariableName + "(" + contextArgument(converter.AcceptsContext()) + fromField.FullVariableName("") + ")"
+	}
+
+	if generateConverter(function, toField, fromField, 0) != "" || generateDeepcopy(toField, fromField, 0) != "" {
+		return ""
+	}
+
+	return generateCast(toField, fromField)
+}
+
+// generateNilGuard generates the statements used to guard the assignment of to-fields
+// from the nil pointers that are dereferenced to reference their from-fields, using the function's nil policy.
+//
+// The error of each nil pointer (i.e `errors.New("models.Account.User is nil")`) is returned using the error nil policy.
+func generateNilGuard(function *models.Function, toFields, pointers []*models.Field, errs []string, allocated map[*models.Field]bool, assignment string) string {
+	if len(pointers) == 0 {
+		return assignment
+	}
+
+	var guarded strings.Builder
+	if function.Options.Nil == models.NilError {
+		for i, pointer := range pointers {
+			guarded.WriteString("if " + pointer.FullVariableName("") + " == nil {\n")
+			guarded.WriteString(generateErrorReturn(function, errs[i]))
+			guarded.WriteString("}\n")
+		}
+
+		guarded.WriteString(assignment)
+		return guarded.String()
+	}
+
+	guarded.WriteString("if " + nonNilConditions(pointers) + " {\n")
+	guarded.WriteString(assignment)
+	if function.Options.Nil == models.NilZero {
+		guarded.WriteString(generateZeroBranch(toFields, allocated))
+	}
+	guarded.WriteString("}\n")
+
+	return guarded.String()
+}
+
+// nilErrors returns the errors of nil pointers (i.e `errors.New("models.Account.User is nil")`).
+func nilErrors(pointers []*models.Field) []string {
+	errs := make([]string, len(pointers))
+	for i, pointer := range pointers {
+		errs[i] = "errors.New(" + strconv.Quote(pointer.FullNameWithoutPointer("")+" is nil") + ")"
+	}
+
+	return errs
+}
+
+// generateZeroBranch generates the branch of a nil guard used to assign the zero value to to-fields.
+//
+// A to-field is already the zero value when its unallocated parents (if any) are nil.
+func generateZeroBranch(toFields []*models.Field, allocated map[*models.Field]bool) string {
+	if len(toFields) == 1 {
+		if parents := unallocatedParents(toFields[0], allocated); len(parents) != 0 {
+			return "} else if " + nonNilConditions(parents) + " {\n" + generateZeroAssignment(toFields[0])
+		}
+	}
+
+	var branch strings.Builder
+	branch.WriteString("} else {\n")
+	for _, toField := range toFields {
+		parents := unallocatedParents(toField, allocated)
+		if len(parents) == 0 {
+			branch.WriteString(generateZeroAssignment(toField))
+			continue
+		}
+
+		branch.WriteString("if " + nonNilConditions(parents) + " {\n")
+		branch.WriteString(generateZeroAssignment(toField))
+		branch.WriteString("}\n")
+	}
+
+	return branch.String()
+}
+
+// generateZeroAssignment generates the statement used to assign the zero value to a to-field.
+func generateZeroAssignment(toField *models.Field) string {
+	return toField.FullVariableName("") + " = " + zeroValue(toField) + "\n"
+}
+
+// nonNilConditions returns the conditions that determine whether every pointer is NOT nil.
+func nonNilConditions(pointers []*models.Field) string {
+	conditions := make([]string, len(pointers))
+	for i, pointer := range pointers {
+		conditions[i] = pointer.FullVariableName("") + " != nil"
+	}
+
+	return strings.Join(conditions, " && ")
+}
+
+// nilPointers returns the pointers that are dereferenced to assign a from-field to a to-field (from the top down):
+// The pointer parents of the from-field (i.e `fA.User` in `fA.User.Name`) and the from-field when its value is assigned (i.e `*fA.Name`),
+// copied using a Copygen function, or copied per element (i.e a nil slice).
+//
+// The from-field's type is NOT returned, unless it's copied using a Copygen function or per element.
+func nilPointers(function *models.Function, toField, fromField *models.Field) []*models.Field {
+	var pointers []*models.Field
+	if (!fromField.IsType() && dereferenced(function, toField, fromField)) || copiedNil(toField, fromField) {
+		pointers = append(pointers, fromField)
+	}
+
+	for parent := fromField.Parent; parent != nil && !parent.IsType(); parent = parent.Parent {
+		if parent.IsPointer() {
+			pointers = append(pointers, parent)
+		}
+	}
+
+	for i, j := 0, len(pointers)-1; i < j; i, j = i+1, j-1 {
+		pointers[i], pointers[j] = pointers[j], pointers[i]
+	}
+
+	return pointers
+}
+
+// dereferenced determines whether a pointer from-field is dereferenced to assign its value to a to-field (i.e `*fA.Name`).
+func dereferenced(function *models.Function, toField, fromField *models.Field) bool {
+	if !fromField.IsPointer() || toField.FullDefinition() != fromField.FullDefinition()[1:] ||
+		fromField.Options.Convert != "" || function.Converter(toField, fromField) != nil {
+		return false
+	}
+
+	// deepcopied pointers are guarded by the deepcopy.
+	modifier := fromField.CastModifier(toField)
+	if modifier == "" {
+		return !fromField.Options.Deepcopy
+	}
+
+	return !models.IsCastProperty(modifier) && !models.IsCastFunction(modifier)
+}
+
+// copiedNil determines whether a from-field that can be nil is copied to a to-field
+// using a Copygen function (i.e `*models.User`) or per element (i.e `[]models.User`).
+func copiedNil(toField, fromField *models.Field) bool {
+	if toField.Copier != nil {
+		return fromField.IsPointer()
+	}
+
+	return toField.MatchedElem != nil && (fromField.IsSlice() || fromField.IsMap())
+}
+
+// zeroValue returns the zero value of a field's definition.
+func zeroValue(field *models.Field) string {
+	typ := field
+	if field.Underlying != nil {
+		typ = field.Underlying
+	}
+
+	switch {
+	case typ.IsPointer() || typ.IsSlice() || typ.IsMap() || typ.IsChan() || typ.IsFunc() || typ.IsInterface():
+		return "nil"
+
+	case typ.Definition == "bool":
+		return "false"
+
+	case typ.Definition == "string":
+		return `""`
+
+	case typ.IsBasic():
+		return "0"
+
+	case typ.IsStruct() || typ.IsArray():
+		return field.FullDefinition() + "{}"
+	}
+
+	return "*new(" + field.FullDefinition() + ")"
+}
+
+// generateElements generates the statements used to copy the elements of a from-field collection
+// to the elements of a to-field collection using a Copygen function or the matched subfields of the elements.
+func generateElements(function *models.Function, toField, fromField *models.Field, level int) string {
+	to, from := toField.FullVariableName(""), fromField.FullVariableName("")
+	path := fromField.FullNameWithoutPointer("")
+	definition := elemDefinition(toField)
+	suffix := levelSuffix(level)
+
+	var copied strings.Builder
+	switch {
+	case toField.IsArray():
+		i := "i" + suffix
+		copied.WriteString("for " + i + " := range " + from + " {\n")
+		copied.WriteString(generateElement(function, toField.MatchedElem, to+"["+i+"]", from+"["+i+"]", definition, path+"[%d]", i, level))
+		copied.WriteString("}\n")
+
+	case toField.IsSlice():
+		i := "i" + suffix
+		copied.WriteString(to + " = make(" + toField.FullDefinition() + ", len(" + from + "))\n")
+		copied.WriteString("for " + i + " := range " + from + " {\n")
+		copied.WriteString(generateElement(function, toField.MatchedElem, to+"["+i+"]", from+"["+i+"]", definition, path+"[%d]", i, level))
+		copied.WriteString("}\n")
+
+	case toField.IsMap():
+		// map elements are not addressable, so the element is copied to a variable.
+		k, v, c := "k"+suffix, "v"+suffix, "c"+suffix
+		copied.WriteString(to + " = make(" + toField.FullDefinition() + ", len(" + from + "))\n")
+		copied.WriteString("for " + k + ", " + v + " := range " + from + " {\n")
+		copied.WriteString("var " + c + " " + definition + "\n")
+		copied.WriteString(generateElement(function, toField.MatchedElem, c, v, definition, path+"[%v]", k, level))
+		copied.WriteString(to + "[" + k + "] = " + c + "\n")
+		copied.WriteString("}\n")
+	}
+
+	return copied.String()
+}
+
+// generateElement generates the statements used to copy a from-element to a to-element.
+//
+// The to-element and from-element are referenced by variables (i.e `tT.Users[i]` and `fT.Users[i]`),
+// while the errors of a nil from-element or a copier that returns an error name the from-element
+// using a path that's formatted with its index (i.e `models.Team.Users[%d]` and `i`).
+func generateElement(function *models.Function, toElem *models.Field, to, from, definition, path, index string, level int) string {
+	fromElem := toElem.From
+	toElem.VariableName, fromElem.VariableName = to, from
+
+	var copied strings.Builder
+
+	// a constructor copier returns a new to-element.
+	if toElem.IsPointer() && (toElem.Copier == nil || !toElem.Copier.Options.Constructor) {
+		copied.WriteString(to + " = new(" + definition[1:] + ")\n")
+	}
+
+	if toElem.Copier != nil {
+		copied.WriteString(generateCopierCall(function, toElem, fromElem, to, from, generateWrappedError(path, index)))
+	} else {
+		copied.WriteString(generateFields(function, toElem.AllFields(nil, nil)[1:], level+1))
+	}
+
+	// nil from-elements are guarded using the function's nil policy.
+	if !fromElem.IsPointer() {
+		return copied.String()
+	}
+
+	nilError := "fmt.Errorf(" + strconv.Quote(path+" is nil") + ", " + index + ")"
+	return generateNilGuard(function, []*models.Field{toElem}, []*models.Field{fromElem}, []string{nilError}, make(map[*models.Field]bool), copied.String())
+}
+
+// generateCopier generates the statements used to copy a from-field to a to-field
+// using the Copygen function that copies them.
+//
+// A nil from-field is guarded using the function's nil policy (in generateFields).
+func generateCopier(function *models.Function, toField, fromField *models.Field) string {
+	to, from := toField.FullVariableName(""), fromField.FullVariableName("")
+
+	var copied strings.Builder
+
+	// a constructor copier returns a new to-field, while a copier copies to an existing to-field (when it's allocated).
+	if toField.IsPointer() && !toField.Copier.Options.Constructor {
+		copied.WriteString(generateAllocation([]*models.Field{toField}))
+	}
+
+	copied.WriteString(generateCopierCall(function, toField, fromField, to, from, generateWrappedError(fromField.FullNameWithoutPointer(""))))
+
+	return copied.String()
+}
+
+// generateCopierCall generates the statement used to call the Copygen function of a to-field,
+// which copies a from-variable to a to-variable (i.e `UserToDomain(tA.User, &fA.User)`).
+//
+// A constructor copier's new to-type is assigned to the to-variable (i.e `tA.User = UserToDomain(&fA.User)`).
+// The wrapped error of a copier that returns an error is returned from the function.
+func generateCopierCall(function *models.Function, toField, fromField *models.Field, to, from, wrapped string) string {
+	copier := toField.Copier
+	switch copierPointer := copier.From[0].Field.IsPointer(); {
+	case copierPointer && !fromField.IsPointer():
+		from = "&" + from
+
+	case !copierPointer && fromField.IsPointer():
+		from = "*" + from
+	}
+
+	if copier.Options.Constructor {
+		call := copier.Name + "(" + contextArgument(copier.Context) + from + ")"
+
+		// a new pointed to-type is dereferenced to assign a to-field that isn't pointed.
+		var dereference string
+		if copier.To[0].Field.IsPointer() && !toField.IsPointer() {
+			dereference = "*"
+		}
+
+		if copier.ReturnsError() {
+			variable := resultVariable("copied", function, toField)
+			return generateFallibleCall(function, variable, call, wrapped) + to + " = " + dereference + variable + "\n"
+		}
+
+		return to + " = " + dereference + call + "\n"
+	}
+
+	if !toField.IsPointer() {
+		to = "&" + to
+	}
+
+	call := copier.Name + "(" + contextArgument(copier.Context) + to + ", " + from + ")"
+	if copier.ReturnsError() {
+		return "if err := " + call + "; err != nil {\n" + generateErrorReturn(function, wrapped) + "}\n"
+	}
+
+	return call + "\n"
+}
+
+// generateWrappedError generates the expression used to wrap an error with the path of the from-field that failed
+// (i.e `fmt.Errorf("models.Account.User: %w", err)`), which is formatted using the given arguments.
+func generateWrappedError(path string, args ...string) string {
+	return "fmt.Errorf(" + strconv.Quote(path+": %w") + ", " + strings.Join(append(args, "err"), ", ") + ")"
+}
+
+// generateErrorReturn generates the statement used to return an error from a function.
+//
+// A constructor returns the zero values of its to-types with the error.
+func generateErrorReturn(function *models.Function, err string) string {
+	if !function.Options.Constructor {
+		return "return " + err + "\n"
+	}
+
+	results := make([]string, 0, len(function.To)+1)
+	for _, toType := range function.To {
+		results = append(results, zeroValue(toType.Field))
+	}
+
+	return "return " + strings.Join(append(results, err), ", ") + "\n"
+}
+
+// elemDefinition returns the definition of the elements of a collection field (i.e `domain.User` in `[]domain.User`).
+func elemDefinition(field *models.Field) string {
+	definition := field.FullDefinition()
+	if !field.IsMap() {
+		return definition[strings.Index(definition, "]")+1:]
+	}
+
+	// the key of a map can contain brackets (i.e `map[[2]int]string`).
+	var brackets int
+	for i := len(models.CollectionMap); i < len(definition); i++ {
+		switch definition[i] {
+		case '[':
+			brackets++
+		case ']':
+			brackets--
+			if brackets == 0 {
+				return definition[i+1:]
+			}
+		}
+	}
+
+	return definition
+}
+
+// levelSuffix returns the suffix of the loop variables used at a level (i.e `1` in `i1`).
+func levelSuffix(level int) string {
+	if level == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(level)
+}
+
+// unallocatedParents returns the nil pointer parents of a to-field that are not allocated yet (from the top down).
+func unallocatedParents(toField *models.Field, allocated map[*models.Field]bool) []*models.Field {
+	var parents []*models.Field
+	for parent := toField.Parent; parent != nil && !parent.IsType(); parent = parent.Parent {
+		if !allocated[parent] && parent.IsPointer() && parent.Elem != nil && !parent.Elem.IsPointer() {
+			parents = append(parents, parent)
+		}
+	}
+
+	for i, j := 0, len(parents)-1; i < j; i, j = i+1, j-1 {
+		parents[i], parents[j] = parents[j], parents[i]
+	}
+
+	return parents
+}
+
+// generateAllocation generates the statements used to allocate the nil pointer parents of a to-field.
+func generateAllocation(parents []*models.Field) string {
+	var allocation strings.Builder
+	for _, parent := range parents {
+		allocation.WriteString("if " + parent.FullVariableName("") + " == nil {\n")
+		allocation.WriteString(parent.FullVariableName("") + " = new(" + parent.FullDefinition()[1:] + ")\n")
+		allocation.WriteString("}\n")
+	}
+
+	return allocation.String()
+}
+
+// generateConverter generates the statements used to convert a from-field to a to-field
+// using a converter parameter of the function or a convert function that returns an error (or "").
+//
+// A converter (i.e `c func(A) B`) is applied to the from-field or the elements of a from-field slice or map.
+func generateConverter(function *models.Function, toField, fromField *models.Field, level int) string {
+	to, from := toField.FullVariableName(""), fromField.FullVariableName("")
+	path := fromField.FullNameWithoutPointer("")
+	if fromField.Options.Convert != "" {
+		if !fromField.Options.ConvertError {
+			return ""
+		}
+
+		return generateConversion(function, to, resultVariable("converted", function, toField), fromField.Options.Convert+"("+contextArgument(fromField.Options.ConvertContext)+from+")", true, generateWrappedError(path))
+	}
+
+	if converter := function.Converter(toField, fromField); converter != nil {
+		return generateConversion(function, to, resultVariable("converted", function, toField), converter.VariableName+"("+contextArgument(converter.AcceptsContext())+from+")", converter.IsFallibleFunc(), generateWrappedError(path))
+	}
+
+	i, k, v := "i"+levelSuffix(level), "k"+levelSuffix(level), "v"+levelSuffix(level)
+
+	var converted strings.Builder
+	switch {
+	case toField.IsSlice() && fromField.IsSlice():
+		converter := function.Converter(toField.Elem, fromField.Elem)
+		if converter == nil {
+			return ""
+		}
+
+		converted.WriteString("if " + from + " != nil {\n")
+		converted.WriteString(to + " = make(" + toField.FullDefinition() + ", len(" + from + "))\n")
+		converted.WriteString("for " + i + " := range " + from + " {\n")
+		converted.WriteString(generateConversion(function, to+"["+i+"]", resultVariable("converted", function, toField), converter.VariableName+"("+contextArgument(converter.AcceptsContext())+from+"["+i+"])", converter.IsFallibleFunc(), generateWrappedError(path+"[%d]", i)))
+		converted.WriteString("}\n")
+		converted.WriteString("}\n")
+
+	case toField.IsMap() && fromField.IsMap():
+		converter := function.Converter(toField.Elem, fromField.Elem)
+		if converter == nil || toField.Key == nil || fromField.Key == nil || toField.Key.FullDefinition() != fromField.Key.FullDefinition() {
+			return ""
+		}
+
+		converted.WriteString("if " + from + " != nil {\n")
+		converted.WriteString(to + " = make(" + toField.FullDefinition() + ", len(" + from + "))\n")
+		converted.WriteString("for " + k + ", " + v + " := range " + from + " {\n")
+		converted.WriteString(generateConversion(function, to+"["+k+"]", resultVariable("converted", function, toField), converter.VariableName+"("+contextArgument(converter.AcceptsContext())+v+")", converter.IsFallibleFunc(), generateWrappedError(path+"[%v]", k)))
+		converted.WriteString("}\n")
+		converted.WriteString("}\n")
+	}
+
+	return converted.String()
+}
+
+// contextParameter represents the name of the context parameter of a function that accepts one.
+const contextParameter = "ctx"
+
+// contextArgument returns the context argument that's passed to a function that accepts a context (or "").
+func contextArgument(context bool) string {
+	if !context {
+		return ""
+	}
+
+	return contextParameter + ", "
+}
+
+// generateConversion generates the statements used to assign the result of a conversion call to a to-variable.
+//
+// The wrapped error of a fallible conversion (i.e `func(A) (B, error)`) is returned from the function,
+// while its result is declared using the given variable.
+func generateConversion(function *models.Function, to, variable, call string, fallible bool, wrapped string) string {
+	if !fallible {
+		return to + " = " + call + "\n"
+	}
+
+	return generateFallibleCall(function, variable, call, wrapped) + to + " = " + variable + "\n"
+}
+
+// generateFallibleCall generates the statements used to declare the result of a call that returns an error
+// (i.e `converted, err := f(x)`), which returns the wrapped error from the function.
+func generateFallibleCall(function *models.Function, variable, call, wrapped string) string {
+	return variable + ", err := " + call + "\n" +
+		"if err != nil {\n" +
+		generateErrorReturn(function, wrapped) +
+		"}\n"
+}
+
+// resultVariable returns the name of the variable used to declare the result of a call that's assigned to a to-field
+// (i.e `convertedUserAge` for `tA.User.Age`), which is unique in the scope of the assignment.
+func resultVariable(prefix string, function *models.Function, toField *models.Field) string {
+	var name string
+	field := toField
+	for ; field != nil && !field.IsType(); field = field.Parent {
+		name = identifier(field.Name) + name
+	}
+
+	// the to-fields of different to-types may have the same names.
+	if field != nil && len(function.To) > 1 {
+		name = identifier(field.VariableName) + name
+	}
+
+	return prefix + name
+}
+
+// identifier returns the characters of a name that are valid in an exported identifier (i.e `TA` in `tA`).
+func identifier(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, name)
+
+	runes := []rune(name)
+	if len(runes) != 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+
+	return string(runes)
+}
+
+// generateCast generates the expression used to cast a from-field to a to-field.
+func generateCast(toField, fromField *models.Field) string {
+	modifier := fromField.CastModifier(toField)
+
+	switch {
+	case models.IsCastProperty(modifier):
+		return fromField.FullVariableName("") + modifier
+
+	case models.IsCastFunction(modifier):
+		return strings.TrimSuffix(modifier, models.CastModifierFunction) + "(" + fromField.FullVariableName("") + ")"
+
+	case modifier != "":
+		return generateAutoCast(toField, fromField) + " " + modifier
+	}
+
+	return generateAutoCast(toField, fromField)
+}
+
+// generateAutoCast generates the expression used to automatically cast a from-field to a to-field.
+func generateAutoCast(toField, fromField *models.Field) string {
+	switch {
+	case toField.FullDefinition() == fromField.FullDefinition():
+		return fromField.FullVariableName("")
+
+	case toField.FullDefinition()[1:] == fromField.FullDefinition():
+		return "&" + fromField.FullVariableName("")
+
+	case toField.FullDefinition() == fromField.FullDefinition()[1:]:
+		return "*" + fromField.FullVariableName("")
+
+	// assign an object to an interface.
+	case toField.IsInterface():
+		return fromField.FullVariableName("")
+
+	// assert an interface into an object.
+	case fromField.IsInterface():
+		return fromField.FullVariableName("") + ".(" + toField.FullDefinition() + ")"
+	}
+
+	// convert a type into another type.
+	definition := toField.FullDefinition()
+	if toField.IsPointer() || toField.IsFunc() || toField.IsChan() {
+		definition = "(" + definition + ")"
+	}
+
+	return definition + "(" + fromField.FullVariableName("") + ")"
+}
+
+// generateDeepcopy generates the statements used to deepcopy a from-field to a to-field (or "").
+//
+// A from-field is only deepcopied when it's assigned to the to-field without a cast or convert function.
+func generateDeepcopy(toField, fromField *models.Field, level int) string {
+	if !fromField.Options.Deepcopy || fromField.Options.Convert != "" || fromField.CastModifier(toField) != "" {
+		return ""
+	}
+
+	to, from := toField.FullVariableName(""), fromField.FullVariableName("")
+	switch {
+	case toField.FullDefinition() == fromField.FullDefinition():
+		return generateDeepcopyField(to, from, fromField, level, nil)
+
+	case toField.IsPointer() && toField.FullDefinition()[1:] == fromField.FullDefinition():
+		return to + " = new(" + fromField.FullDefinition() + ")\n" +
+			generateDeepcopyField("*"+to, from, fromField, level, nil)
+
+	case fromField.IsPointer() && toField.FullDefinition() == fromField.FullDefinition()[1:] && fromField.Elem != nil:
+		return "if " + from + " != nil {\n" +
+			generateDeepcopyField(to, "*"+from, fromField.Elem, level, nil) +
+			"}\n"
+	}
+
+	return ""
+}
+
+// generateDeepcopyField generates the statements used to deepcopy a from-variable to a to-variable
+// using the definition of a field.
+//
+// The level represents the depth of nested collections, which is used to name loop variables.
+func generateDeepcopyField(to, from string, field *models.Field, level int, cyclic map[*models.Field]bool) string {
+	if cyclic == nil {
+		cyclic = make(map[*models.Field]bool)
+	}
+
+	// cyclic fields (i.e `Next` in `type Node struct { Next *Node }`) are shallow copied.
+	if cyclic[field] || !hasReferences(field, nil) {
+		return to + " = " + from + "\n"
+	}
+
+	cyclic[field] = true
+	defer delete(cyclic, field)
+
+	// the underlying field of a named type determines how it's copied.
+	typ := field
+	if field.Underlying != nil {
+		typ = field.Underlying
+	}
+
+	suffix := levelSuffix(level)
+
+	var copied strings.Builder
+	switch {
+	case typ.IsPointer():
+		copied.WriteString("if " + from + " != nil {\n")
+		copied.WriteString(to + " = new(" + typ.Elem.FullDefinition() + ")\n")
+		copied.WriteString(generateDeepcopyField("*"+to, "*"+from, typ.Elem, level, cyclic))
+		copied.WriteString("}\n")
+
+	case typ.IsSlice():
+		copied.WriteString("if " + from + " != nil {\n")
+		copied.WriteString(to + " = make(" + field.FullDefinition() + ", len(" + from + "))\n")
+		if hasReferences(typ.Elem, nil) {
+			i := "i" + suffix
+			copied.WriteString("for " + i + " := range " + from + " {\n")
+			copied.WriteString(generateDeepcopyField(operand(to)+"["+i+"]", operand(from)+"["+i+"]", typ.Elem, level+1, cyclic))
+			copied.WriteString("}\n")
+		} else {
+			copied.WriteString("copy(" + to + ", " + from + ")\n")
+		}
+		copied.WriteString("}\n")
+
+	case typ.IsArray():
+		i := "i" + suffix
+		copied.WriteString(to + " = " + from + "\n")
+		copied.WriteString("for " + i + " := range " + from + " {\n")
+		copied.WriteString(generateDeepcopyField(operand(to)+"["+i+"]", operand(from)+"["+i+"]", typ.Elem, level+1, cyclic))
+		copied.WriteString("}\n")
+
+	case typ.IsMap():
+		k, v := "k"+suffix, "v"+suffix
+		copied.WriteString("if " + from + " != nil {\n")
+		copied.WriteString(to + " = make(" + field.FullDefinition() + ", len(" + from + "))\n")
+		copied.WriteString("for " + k + ", " + v + " := range " + from + " {\n")
+		if hasReferences(typ.Elem, nil) {
+			// map elements are not addressable, so the element is copied to a variable.
+			c := "c" + suffix
+			copied.WriteString("var " + c + " " + typ.Elem.FullDefinition() + "\n")
+			copied.WriteString(generateDeepcopyField(c, v, typ.Elem, level+1, cyclic))
+			copied.WriteString(operand(to) + "[" + k + "] = " + c + "\n")
+		} else {
+			copied.WriteString(operand(to) + "[" + k + "] = " + v + "\n")
+		}
+		copied.WriteString("}\n")
+		copied.WriteString("}\n")
+
+	case typ.IsStruct():
+		copied.WriteString(to + " = " + from + "\n")
+		for _, subfield := range typ.Fields {
+			// unexported fields of other packages can't be referenced.
+			if !isExported(subfield.Name) && field.Package != "" {
+				continue
+			}
+
+			if hasReferences(subfield, nil) {
+				copied.WriteString(generateDeepcopyField(selector(to)+"."+subfield.Name, selector(from)+"."+subfield.Name, subfield, level, cyclic))
+			}
+		}
+
+	default:
+		copied.WriteString(to + " = " + from + "\n")
+	}
+
+	return copied.String()
+}
+
+// hasReferences determines whether a field references values that are shared by its shallow copies.
+func hasReferences(field *models.Field, cyclic map[*models.Field]bool) bool {
+	if field == nil {
+		return false
+	}
+
+	if cyclic == nil {
+		cyclic = make(map[*models.Field]bool)
+	}
+
+	if cyclic[field] {
+		return false
+	}
+
+	cyclic[field] = true
+
+	typ := field
+	if field.Underlying != nil {
+		typ = field.Underlying
+	}
+
+	switch {
+	case typ.IsPointer() || typ.IsSlice():
+		return typ.Elem != nil
+
+	case typ.IsMap():
+		return typ.Key != nil && typ.Elem != nil
+
+	case typ.IsArray():
+		return hasReferences(typ.Elem, cyclic)
+
+	case typ.IsStruct():
+		for _, subfield := range typ.Fields {
+			if hasReferences(subfield, cyclic) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// operand returns a variable that can be used as the operand of an index expression.
+func operand(variable string) string {
+	if strings.HasPrefix(variable, "*") {
+		return "(" + variable + ")"
+	}
+
+	return variable
+}
+
+// selector returns a variable that can be used as the operand of a selector expression.
+func selector(variable string) string {
+	// selectors automatically dereference pointers to structs.
+	if strings.HasPrefix(variable, "*") && !strings.HasPrefix(variable, "**") {
+		return variable[1:]
+	}
+
+	return operand(variable)
+}
+
+// isExported determines whether a field name is exported.
+func isExported(name string) bool {
+	return name != "" && unicode.IsUpper([]rune(name)[0])
+}
+
 // generateReturn generates a return statement for the function.
 func generateReturn(function *models.Function) string {
-	return "}"
+	var results []string
+	if function.Options.Constructor {
+		for _, toType := range function.To {
+			results = append(results, toType.Field.VariableName)
+		}
+	}
+
+	if function.ReturnsError() {
+		results = append(results, "nil")
+	}
+
+	if len(results) == 0 {
+		return "}"
+	}
+
+	return "return " + strings.Join(results, ", ") + "\n}"
 }
@@ -0,0 +1,96 @@
+package matcher
+
+import (
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+// autocast determines whether automatic casting is enabled for a to-field and from-field.
+//
+// Automatic casting is enabled for every field using the generator's matcher options,
+// or for specific fields using the cast option modifier (i.e `automatch .* -cast`).
+func autocast(options models.MatcherOptions, toField, fromField *models.Field) bool {
+	return options.AutoCast || toField.Options.AutoCast || fromField.Options.AutoCast
+}
+
+// castable determines whether a from-field can be casted to a to-field.
+//
+// A from-field is castable when its cast modifier (`.Property`, `Function()`) is assumed
+// to return the to-field's definition, or when an automatic cast is possible
+// using type conversion, assignment to an interface, or assertion of an interface.
+func castable(options models.MatcherOptions, toField, fromField *models.Field) bool {
+	depth := options.CastDepth
+	if fromField.Options.CastDepth != nil && fromField.CastsTo(toField) {
+		depth = *fromField.Options.CastDepth
+	}
+
+	// a modifier is applied to a from-field regardless of depth.
+	modifier := fromField.CastModifier(toField)
+	if models.IsCastProperty(modifier) || models.IsCastFunction(modifier) {
+		return true
+	}
+
+	if depth <= 0 {
+		return false
+	}
+
+	switch {
+	case toField.IsInterface():
+		return !options.DisableAssignObjectInterface && implements(fromField, toField)
+
+	case fromField.IsInterface():
+		return !options.DisableAssertInterfaceObject && implements(toField, fromField)
+
+	case !options.DisableConvert:
+		level := convertible(toField, fromField)
+		return level != -1 && level <= depth
+	}
+
+	return false
+}
+
+// implements determines whether an object field implements an interface field.
+func implements(object, iface *models.Field) bool {
+	methods := make(map[string]bool, len(object.Methods))
+	for _, method := range object.Methods {
+		methods[method] = true
+	}
+
+	for _, method := range iface.Methods {
+		if !methods[method] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// convertible returns the depth-level at which a from-field is converted to a to-field (or -1).
+//
+// The depth-level represents the amount of type definitions between the two fields.
+// For example, `type UserID int` is converted to an `int` at a depth-level of 1,
+// while a `type AccountID int` is converted to a `type UserID int` at a depth-level of 2.
+func convertible(toField, fromField *models.Field) int {
+	toDefinitions := definitions(toField)
+	fromDefinitions := definitions(fromField)
+
+	level := -1
+	for i, toDefinition := range toDefinitions {
+		for j, fromDefinition := range fromDefinitions {
+			if toDefinition == fromDefinition && (level == -1 || i+j < level) {
+				level = i + j
+			}
+		}
+	}
+
+	return level
+}
+
+// definitions returns the definitions of a field and its underlying fields (in order).
+func definitions(field *models.Field) []string {
+	var defs []string
+	for f := field; f != nil; f = f.Underlying {
+		defs = append(defs, f.FullDefinition())
+	}
+
+	return defs
+}
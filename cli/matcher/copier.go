@@ -0,0 +1,60 @@
+package matcher
+
+import (
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+// copier returns the Copygen function that copies a from-field to a to-field (or nil).
+//
+// A copier copies one from-type to one pointed to-type (i.e `UserToDomain(*models.User) *domain.User`).
+// A constructor copier returns a new to-type, which is only pointed when the to-field is pointed.
+// The fields are copied when they are pointed at most once.
+//
+// A copier that returns an error is only called by a function that returns an error,
+// while a copier that accepts a context is only called by a function that accepts one.
+func copier(gen *models.Generator, function models.Function, toField, fromField *models.Field) *models.Function {
+	if strings.HasPrefix(toField.Definition, "**") || strings.HasPrefix(fromField.Definition, "**") {
+		return nil
+	}
+
+	for i, candidate := range gen.Functions {
+		if len(candidate.To) != 1 || len(candidate.From) != 1 || len(candidate.TypeParams) != 0 ||
+			(candidate.ReturnsError() && !function.ReturnsError()) || (candidate.Context && !function.Context) {
+			continue
+		}
+
+		toType, fromType := candidate.To[0].Field, candidate.From[0].Field
+		if strings.HasPrefix(toType.Definition, "**") || strings.HasPrefix(fromType.Definition, "**") {
+			continue
+		}
+
+		// a to-type parameter is only assigned when it's pointed, while a new to-type value isn't pointed.
+		if candidate.Options.Constructor && !toType.IsPointer() && toField.IsPointer() ||
+			!candidate.Options.Constructor && !toType.IsPointer() {
+			continue
+		}
+
+		if toType.FullDefinitionWithoutPointer() == toField.FullDefinitionWithoutPointer() &&
+			fromType.FullDefinitionWithoutPointer() == fromField.FullDefinitionWithoutPointer() {
+			return &gen.Functions[i]
+		}
+	}
+
+	return nil
+}
+
+// fieldCopier returns the Copygen function that copies a from-field to a to-field when they're matched (or nil).
+//
+// Types (i.e the parameters of a function) and fields that are assigned, casted, or converted
+// are NOT copied using a Copygen function.
+func fieldCopier(gen *models.Generator, function models.Function, toField, fromField *models.Field) *models.Function {
+	if toField.IsType() || fromField.IsType() || assignable(toField, fromField) ||
+		fromField.Options.Convert != "" || fromField.CastModifier(toField) != "" ||
+		function.Converter(toField, fromField) != nil {
+		return nil
+	}
+
+	return copier(gen, function, toField, fromField)
+}
@@ -0,0 +1,86 @@
+package matcher
+
+import (
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+// elements determines whether the elements of a from-field collection are copied to the elements
+// of a to-field collection (i.e `[]models.User` to `[]domain.User`), then matches them.
+//
+// Elements are copied using a Copygen function of the generator (i.e `UserToDomain(*models.User) *domain.User`)
+// or by automatching the subfields of the elements (when at least one subfield is matched).
+func elements(gen *models.Generator, function models.Function, toField, fromField *models.Field, cyclic map[string]bool) bool {
+	if !collected(toField, fromField) {
+		return false
+	}
+
+	// the elements of cyclic types (i.e `Children []Node`) are NOT matched recursively.
+	pair := toField.Elem.FullDefinition() + " " + fromField.Elem.FullDefinition()
+	if cyclic[pair] {
+		return false
+	}
+
+	toElem, fromElem := toField.Elem.Deepcopy(nil), fromField.Elem.Deepcopy(nil)
+	toElem.Copier = copier(gen, function, toElem, fromElem)
+	if toElem.Copier == nil {
+		if cyclic == nil {
+			cyclic = make(map[string]bool)
+		}
+
+		cyclic[pair] = true
+		defer delete(cyclic, pair)
+
+		if !matchSubfields(gen, function, toElem, fromElem, cyclic) {
+			return false
+		}
+	}
+
+	toElem.From = fromElem
+	fromElem.To = toElem
+	toField.MatchedElem = toElem
+
+	return true
+}
+
+// collected determines whether two fields are collections of the same kind with elements that can be matched:
+// Slices, arrays of the same length, or maps with the same key.
+func collected(toField, fromField *models.Field) bool {
+	if toField.Elem == nil || fromField.Elem == nil {
+		return false
+	}
+
+	switch {
+	case toField.IsSlice() && fromField.IsSlice():
+		return true
+
+	case toField.IsArray() && fromField.IsArray():
+		return toField.Definition[:strings.Index(toField.Definition, "]")] == fromField.Definition[:strings.Index(fromField.Definition, "]")]
+
+	case toField.IsMap() && fromField.IsMap():
+		return toField.Key != nil && fromField.Key != nil && toField.Key.FullDefinition() == fromField.Key.FullDefinition()
+	}
+
+	return false
+}
+
+// matchSubfields automatches the subfields of a from-field to the subfields of a to-field,
+// then returns whether a subfield is matched.
+func matchSubfields(gen *models.Generator, function models.Function, toField, fromField *models.Field, cyclic map[string]bool) bool {
+	toFields := toField.AllFields(nil, nil)[1:]
+	fromFields := fromField.AllFields(nil, nil)[1:]
+
+	var matched bool
+	for i := 0; i < len(toFields); i++ {
+		for j := 0; j < len(fromFields); j++ {
+			automatch(gen, function, toFields[i], fromFields[j], cyclic)
+			if toFields[i].From != nil {
+				matched = true
+				break
+			}
+		}
+	}
+
+	return matched
+}
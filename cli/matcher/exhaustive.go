@@ -0,0 +1,48 @@
+package matcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+// checkExhaustive returns an error when a function contains to-fields that are not matched (or ignored).
+func checkExhaustive(function models.Function) error {
+	var unmatched []*models.Field
+	cyclic := make(map[*models.Field]bool)
+	for _, toType := range function.To {
+		unmatched = unmatchedFields(toType.Field, unmatched, cyclic)
+	}
+
+	if len(unmatched) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(unmatched))
+	for i, field := range unmatched {
+		names[i] = field.FullNameWithoutPointer("")
+	}
+
+	return fmt.Errorf("the function %v contains to-fields that are not matched (or ignored):\n\t%v", function.Name, strings.Join(names, "\n\t"))
+}
+
+// unmatchedFields returns the fields of a to-field that are not matched (or ignored).
+//
+// A field with subfields is matched when each of its subfields is matched.
+func unmatchedFields(field *models.Field, unmatched []*models.Field, cyclic map[*models.Field]bool) []*models.Field {
+	if cyclic[field] || field.From != nil || field.Options.Ignore {
+		return unmatched
+	}
+
+	cyclic[field] = true
+	if len(field.Fields) == 0 {
+		return append(unmatched, field)
+	}
+
+	for _, subfield := range field.Fields {
+		unmatched = unmatchedFields(subfield, unmatched, cyclic)
+	}
+
+	return unmatched
+}
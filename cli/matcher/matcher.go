@@ -2,11 +2,16 @@
 package matcher
 
 import (
+	"errors"
+
 	"github.com/switchupcb/copygen/cli/models"
 )
 
 // Match matches the fields of a parsed generator.
+//
+// In exhaustive mode, Match returns an error when a function contains to-fields that are not matched (or ignored).
 func Match(gen *models.Generator) error {
+	var errs []error
 	for _, function := range gen.Functions {
 		for _, toType := range function.To {
 			for _, fromType := range function.From {
@@ -18,7 +23,7 @@ func Match(gen *models.Generator) error {
 				// each toField is compared to every fromField.
 				for i := 0; i < len(toFields); i++ {
 					for j := 0; j < len(fromFields); j++ {
-						match(function, toFields[i], fromFields[j])
+						match(gen, function, toFields[i], fromFields[j])
 						if toFields[i].From != nil {
 							break
 						}
@@ -26,6 +31,16 @@ func Match(gen *models.Generator) error {
 				}
 			}
 		}
+
+		if gen.Options.Matcher.Exhaustive {
+			if err := checkExhaustive(function); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) != 0 {
+		return errors.Join(errs...)
 	}
 
 	RemoveUnpointedFields(gen)
@@ -33,33 +48,55 @@ func Match(gen *models.Generator) error {
 }
 
 // match determines which matcher to use for two fields, then matches them.
-func match(function models.Function, toField *models.Field, fromField *models.Field) {
+func match(gen *models.Generator, function models.Function, toField *models.Field, fromField *models.Field) {
+	// ignored fields are intentionally unmatched.
+	if toField.Options.Ignore || fromField.Options.Ignore {
+		return
+	}
+
 	if function.Options.Manual {
 		switch {
 		case toField.Options.Automatch || fromField.Options.Automatch:
-			automatch(toField, fromField)
+			automatch(gen, function, toField, fromField, nil)
 
 		case toField.Options.Tag != "":
-			tagmatch(toField, fromField)
+			tagmatch(gen.Options.Matcher, toField, fromField)
 
 		default:
-			mapmatch(toField, fromField)
+			mapmatch(gen.Options.Matcher, toField, fromField)
+		}
+
+		// a manually matched field pair with a dedicated Copygen function is copied using that function.
+		if toField.From == fromField {
+			toField.Copier = fieldCopier(gen, function, toField, fromField)
 		}
 	} else {
-		automatch(toField, fromField)
+		automatch(gen, function, toField, fromField, nil)
 	}
 }
 
 // automatch automatically matches the fields of a fromType to a toType by name and definition.
 // automatch is used when no `map` or `tag` options apply to a field.
-func automatch(toField, fromField *models.Field) {
-	if toField.Name == fromField.Name &&
-		((toField.FullDefinition() == fromField.FullDefinition() ||
-			toField.FullDefinition()[1:] == fromField.FullDefinition() ||
-			toField.FullDefinition() == fromField.FullDefinition()[1:]) ||
-			fromField.Options.Convert != "") {
+//
+// The names of fields are compared using the naming strategy of the function (or generator).
+//
+// The elements of collections with different element types are matched as a last resort,
+// using the elements that are being matched in the current scope (cyclic).
+func automatch(gen *models.Generator, function models.Function, toField, fromField *models.Field, cyclic map[string]bool) {
+	options := gen.Options.Matcher
+	naming := naming(function, options)
+	if namesMatch(naming, toField, fromField) && !hasExactSibling(toField, fromField) &&
+		(assignable(toField, fromField) ||
+			fromField.Options.Convert != "" ||
+			converted(function, toField, fromField) ||
+			fieldCopier(gen, function, toField, fromField) != nil ||
+			(autocast(options, toField, fromField) && castable(options, toField, fromField)) ||
+			elements(gen, function, toField, fromField, cyclic)) {
+		previous := fromField.To
 		fromField.To = toField
 		toField.From = fromField
+		toField.Copier = fieldCopier(gen, function, toField, fromField)
+		warnAmbiguous(function, naming, toField, fromField, previous)
 
 		// prevent parallel matching.
 		fromField.Fields = make([]*models.Field, 0)
@@ -67,10 +104,38 @@ func automatch(toField, fromField *models.Field) {
 	}
 }
 
+// assignable determines whether a from-field is assigned to a to-field without casting.
+func assignable(toField, fromField *models.Field) bool {
+	return toField.FullDefinition() == fromField.FullDefinition() ||
+		toField.FullDefinition()[1:] == fromField.FullDefinition() ||
+		toField.FullDefinition() == fromField.FullDefinition()[1:]
+}
+
+// converted determines whether a from-field is converted to a to-field using a converter
+// from-type of the function (i.e `func(A) B`), which is applied to the from-field
+// or the elements of a from-field slice or map.
+func converted(function models.Function, toField, fromField *models.Field) bool {
+	switch {
+	case function.Converter(toField, fromField) != nil:
+		return true
+
+	case toField.IsSlice() && fromField.IsSlice():
+		return function.Converter(toField.Elem, fromField.Elem) != nil
+
+	case toField.IsMap() && fromField.IsMap():
+		return toField.Key != nil && fromField.Key != nil &&
+			toField.Key.FullDefinition() == fromField.Key.FullDefinition() &&
+			function.Converter(toField.Elem, fromField.Elem) != nil
+	}
+
+	return false
+}
+
 // mapmatch manually maps a from-field to a to-field.
 // mapmatch is used when a map option is specified.
-func mapmatch(toField, fromField *models.Field) {
-	if fromField.Options.Map != "" && toField.FullNameWithoutPointer("") == fromField.Options.Map {
+func mapmatch(options models.MatcherOptions, toField, fromField *models.Field) {
+	if fromField.Options.Map != "" && toField.IsFullName(fromField.Options.Map) &&
+		(!fromField.Options.AutoCast || assignable(toField, fromField) || castable(options, toField, fromField)) {
 		fromField.To = toField
 		toField.From = fromField
 	}
@@ -78,8 +143,9 @@ func mapmatch(toField, fromField *models.Field) {
 
 // tagmatch manually maps a from-field to a to-field using tags.
 // tagmatch is used when a tag option is specified.
-func tagmatch(toField, fromField *models.Field) {
-	if toField.Options.Tag != "" && toField.Options.Tag == fromField.Options.Tag {
+func tagmatch(options models.MatcherOptions, toField, fromField *models.Field) {
+	if toField.Options.Tag != "" && toField.Options.Tag == fromField.Options.Tag &&
+		(!fromField.Options.AutoCast || assignable(toField, fromField) || castable(options, toField, fromField)) {
 		fromField.To = toField
 		toField.From = fromField
 	}
@@ -0,0 +1,147 @@
+package matcher
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+// naming returns the naming strategy of a function's automatcher.
+func naming(function models.Function, options models.MatcherOptions) models.NamingOptions {
+	if function.Options.Naming != nil {
+		return *function.Options.Naming
+	}
+
+	return options.Naming
+}
+
+// namesMatch determines whether the names of two fields are equal using a naming strategy.
+func namesMatch(naming models.NamingOptions, toField, fromField *models.Field) bool {
+	for _, toName := range fieldNames(naming, toField) {
+		for _, fromName := range fieldNames(naming, fromField) {
+			if toName == fromName {
+				return true
+			}
+		}
+	}
+
+	// the path of a nested field is equal to the name of a flat field.
+	if naming.Flatten && !toField.IsType() && !fromField.IsType() {
+		return fieldPath(naming, toField) == fieldPath(naming, fromField)
+	}
+
+	return false
+}
+
+// fieldPath returns the concatenated names of a field and its parents (excluding its type)
+// using a naming strategy (i.e `AddressCity` for `domain.User.Address.City`).
+func fieldPath(naming models.NamingOptions, field *models.Field) string {
+	path := normalize(naming, field.Name)
+	for parent := field.Parent; parent != nil && !parent.IsType(); parent = parent.Parent {
+		path = normalize(naming, parent.Name) + path
+	}
+
+	return path
+}
+
+// fieldNames returns the names of a field that are compared using a naming strategy:
+// The field's name and the name of its struct tag (when specified).
+func fieldNames(naming models.NamingOptions, field *models.Field) []string {
+	names := []string{normalize(naming, field.Name)}
+	if naming.Tag != "" {
+		for name := range field.Tags[naming.Tag] {
+			if name != "" && name != "-" {
+				names = append(names, normalize(naming, name))
+			}
+		}
+	}
+
+	return names
+}
+
+// normalize returns the name of a field using a naming strategy.
+//
+// i.e `DbUser_ID` is normalized to `userid` using the `Db` prefix, snake case, and case-insensitivity.
+func normalize(naming models.NamingOptions, name string) string {
+	insensitive := naming.Insensitive || naming.Snake
+
+	for _, prefix := range naming.Prefixes {
+		if len(name) > len(prefix) && equalName(name[:len(prefix)], prefix, insensitive) {
+			name = name[len(prefix):]
+			break
+		}
+	}
+
+	for _, suffix := range naming.Suffixes {
+		if len(name) > len(suffix) && equalName(name[len(name)-len(suffix):], suffix, insensitive) {
+			name = name[:len(name)-len(suffix)]
+			break
+		}
+	}
+
+	if naming.Snake {
+		name = strings.ReplaceAll(name, "_", "")
+	}
+
+	if insensitive {
+		name = strings.ToLower(name)
+	}
+
+	return name
+}
+
+// equalName determines whether two names are equal (case-insensitively when specified).
+func equalName(a, b string, insensitive bool) bool {
+	if insensitive {
+		return strings.EqualFold(a, b)
+	}
+
+	return a == b
+}
+
+// hasExactSibling determines whether a from-field with a different name than a to-field
+// has a sibling with the same name as the to-field, which is matched instead.
+func hasExactSibling(toField, fromField *models.Field) bool {
+	if toField.Name == fromField.Name || fromField.Parent == nil {
+		return false
+	}
+
+	for _, sibling := range fromField.Parent.Fields {
+		if sibling.Name == toField.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// warnAmbiguous prints a warning when a to-field's name matches the names of multiple
+// from-fields of the same type or field, or when a from-field is matched to multiple
+// to-fields of the same type or field (using a naming strategy).
+//
+// The previous to-field represents the to-field the from-field was matched to before (or nil).
+func warnAmbiguous(function models.Function, naming models.NamingOptions, toField, fromField, previous *models.Field) {
+	if previous != nil && previous.Parent == toField.Parent && (previous.Name != fromField.Name || toField.Name != fromField.Name) {
+		fmt.Printf("WARNING: function %v: the from-field %v matches multiple to-fields: %v, %v\n",
+			function.Name, fromField.FullNameWithoutPointer(""), previous.FullNameWithoutPointer(""), toField.FullNameWithoutPointer(""),
+		)
+	}
+
+	if fromField.Parent == nil {
+		return
+	}
+
+	var ambiguous []string
+	for _, sibling := range fromField.Parent.Fields {
+		if sibling != fromField && namesMatch(naming, toField, sibling) {
+			ambiguous = append(ambiguous, sibling.FullNameWithoutPointer(""))
+		}
+	}
+
+	if len(ambiguous) != 0 {
+		fmt.Printf("WARNING: function %v: the to-field %v matches multiple from-fields: %v is used instead of %v\n",
+			function.Name, toField.FullNameWithoutPointer(""), fromField.FullNameWithoutPointer(""), strings.Join(ambiguous, ", "),
+		)
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import "strings"
+
+// Cast modifiers are applied to a from-field when it's casted to a to-field.
+const (
+	// CastModifierProperty represents the prefix of a modifier that references
+	// a property or method of the field (i.e `.Property` or `.String()`).
+	CastModifierProperty = "."
+
+	// CastModifierFunction represents the suffix of a modifier that calls a function
+	// using the field as a parameter (i.e `Convert()`).
+	CastModifierFunction = "()"
+)
+
+// CastsTo returns whether the field's cast options apply when it's casted to a to-field.
+func (f *Field) CastsTo(toField *Field) bool {
+	return f.Options.CastTo == "" || toField.IsFullName(f.Options.CastTo)
+}
+
+// CastModifier returns the modifier that is applied to the field when it's casted to a to-field (or "").
+func (f *Field) CastModifier(toField *Field) string {
+	if !f.CastsTo(toField) {
+		return ""
+	}
+
+	return f.Options.Cast
+}
+
+// IsCastProperty returns whether a cast modifier references a property or method (i.e `.String()`).
+func IsCastProperty(modifier string) bool {
+	return strings.HasPrefix(modifier, CastModifierProperty)
+}
+
+// IsCastFunction returns whether a cast modifier calls a function (i.e `Convert()`).
+func IsCastFunction(modifier string) bool {
+	return strings.HasSuffix(modifier, CastModifierFunction) && !strings.ContainsAny(modifier, " \t")
+}
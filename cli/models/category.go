@@ -1,5 +1,7 @@
 package models
 
+import "strings"
+
 // IsType returns whether the field is a type.
 func (f *Field) IsType() bool {
 	return f.Parent == nil
@@ -85,6 +87,17 @@ func (f *Field) IsFunc() bool {
 	return len(f.Definition) >= 4 && f.Definition[:4] == CollectionFunc
 }
 
+// AcceptsContext returns whether the field is a function that accepts a context as its first parameter
+// (i.e `func(context.Context, A) B`).
+func (f *Field) AcceptsContext() bool {
+	return f.IsFunc() && f.Context
+}
+
+// IsFallibleFunc returns whether the field is a function that returns a result and an error (i.e `func(A) (B, error)`).
+func (f *Field) IsFallibleFunc() bool {
+	return f.IsFunc() && strings.HasSuffix(f.Definition, ", error)")
+}
+
 // IsInterface returns whether the field is an interface.
 func (f *Field) IsInterface() bool {
 	if f.Underlying != nil {
@@ -94,6 +107,15 @@ func (f *Field) IsInterface() bool {
 	return len(f.Definition) >= 9 && f.Definition[:9] == CollectionInterface
 }
 
+// IsStruct returns whether the field is a struct.
+func (f *Field) IsStruct() bool {
+	if f.Underlying != nil {
+		return f.Underlying.IsStruct()
+	}
+
+	return len(f.Definition) >= 6 && f.Definition[:6] == "struct"
+}
+
 // IsCollection returns whether the field is a collection.
 func (f *Field) IsCollection() bool {
 	return f.IsPointer() || f.IsComposite() || f.IsFunc() || f.IsInterface()
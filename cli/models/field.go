@@ -3,6 +3,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 )
 
 // Field represents a field to be copied to/from.
@@ -40,6 +41,18 @@ type Field struct {
 	// Underlying fields of the same type point to the same *Field object.
 	Underlying *Field
 
+	// Elem represents the element field of a pointer, array, slice, map, or chan field (or nil).
+	// The element field of a `[]string` *Field is a `string` *Field.
+	//
+	// Element fields of the same type point to the same *Field object.
+	Elem *Field
+
+	// Key represents the key field of a map field (or nil).
+	// The key field of a `map[string]bool` *Field is a `string` *Field.
+	//
+	// Key fields of the same type point to the same *Field object.
+	Key *Field
+
 	// The field that this field is copied from (or nil).
 	//
 	// Set in the matcher.
@@ -50,24 +63,58 @@ type Field struct {
 	// Set in the matcher.
 	To *Field
 
+	// MatchedElem represents a copy of the element field of a collection field (or nil),
+	// which is copied from a copy of the element field of this field's from-field.
+	//
+	// MatchedElem is used to copy each element of a collection (i.e `[]models.User` to `[]domain.User`).
+	//
+	// Set in the matcher.
+	MatchedElem *Field
+
+	// Copier represents the Copygen function that copies this field's from-field to this field (or nil).
+	//
+	// Set in the matcher.
+	Copier *Function
+
 	// The fields of this field.
 	Fields []*Field
 
+	// The method set of the field's type (i.e `String() string`).
+	//
+	// Methods are used to determine whether a field implements an interface.
+	Methods []string
+
 	// The custom options of a field.
 	Options FieldOptions
 
 	// Embedded represents whether the field is an embedded field.
 	Embedded bool
+
+	// Context represents whether the field is a function that accepts a context as its first parameter
+	// (i.e `func(context.Context, A) B`), which is determined using its type.
+	Context bool
 }
 
 // FieldOptions represent options for a Field.
 type FieldOptions struct {
-	// The function the field is casted with.
+	// The modifier the field is casted with (i.e `.String()`).
 	Cast string
 
+	// The full name of the to-field the field's cast option applies to (i.e `domain.Account.ID`).
+	CastTo string
+
+	// The maximum depth the field is casted at (or nil when it's unset).
+	CastDepth *int
+
 	// The function the field is converted with (as a parameter).
 	Convert string
 
+	// Whether the function the field is converted with returns an error (i.e `func(A) (B, error)`).
+	ConvertError bool
+
+	// Whether the function the field is converted with accepts a context as its first parameter (i.e `func(context.Context, A) B`).
+	ConvertContext bool
+
 	// The field to map this field to, if any.
 	Map string
 
@@ -80,11 +127,17 @@ type FieldOptions struct {
 	// Whether the field should be explicitly automatched.
 	Automatch bool
 
+	// Whether the field should be automatically casted.
+	AutoCast bool
+
 	// Whether the field should be deepcopied.
 	Deepcopy bool
+
+	// Whether the field is intentionally unmatched.
+	Ignore bool
 }
 
-// Deepcopy returns a new field with copied properties (excluding Parent, To, and From fields).
+// Deepcopy returns a new field with copied properties (excluding Parent and the properties set in the matcher).
 func (f *Field) Deepcopy(cyclic map[*Field]bool) *Field {
 	copied := &Field{
 		VariableName: f.VariableName,
@@ -93,16 +146,29 @@ func (f *Field) Deepcopy(cyclic map[*Field]bool) *Field {
 		Name:         f.Name,
 		Definition:   f.Definition,
 		Underlying:   f.Underlying,
+		Elem:         f.Elem,
+		Key:          f.Key,
 		Options: FieldOptions{
-			Cast:      f.Options.Cast,
-			Convert:   f.Options.Convert,
-			Map:       f.Options.Map,
-			Tag:       f.Options.Tag,
-			Depth:     f.Options.Depth,
-			Automatch: f.Options.Automatch,
-			Deepcopy:  f.Options.Deepcopy,
+			Cast:           f.Options.Cast,
+			CastTo:         f.Options.CastTo,
+			Convert:        f.Options.Convert,
+			ConvertError:   f.Options.ConvertError,
+			ConvertContext: f.Options.ConvertContext,
+			Map:            f.Options.Map,
+			Tag:            f.Options.Tag,
+			Depth:          f.Options.Depth,
+			Automatch:      f.Options.Automatch,
+			AutoCast:       f.Options.AutoCast,
+			Deepcopy:       f.Options.Deepcopy,
+			Ignore:         f.Options.Ignore,
 		},
 		Embedded: f.Embedded,
+		Context:  f.Context,
+	}
+
+	if f.Options.CastDepth != nil {
+		depth := *f.Options.CastDepth
+		copied.Options.CastDepth = &depth
 	}
 
 	copied.Tags = make(map[string]map[string][]string, len(f.Tags))
@@ -114,6 +180,9 @@ func (f *Field) Deepcopy(cyclic map[*Field]bool) *Field {
 		}
 	}
 
+	copied.Methods = make([]string, len(f.Methods))
+	copy(copied.Methods, f.Methods)
+
 	// setup the cache
 	if cyclic == nil {
 		cyclic = make(map[*Field]bool)
@@ -213,6 +282,14 @@ func (f *Field) FullNameWithoutPointer(name string) string {
 	return f.FullDefinitionWithoutPointer() + name
 }
 
+// IsFullName returns whether a name refers to the full name of a field without the pointer.
+//
+// Whitespace is ignored, such that the name of a field with type arguments
+// is referenced without whitespace (i.e domain.Pair[string,int].Key).
+func (f *Field) IsFullName(name string) bool {
+	return strings.ReplaceAll(f.FullNameWithoutPointer(""), " ", "") == strings.ReplaceAll(name, " ", "")
+}
+
 // FullName returns the full name of a field including its parents (i.e *domain.Account.User.ID).
 func (f *Field) FullName() string {
 	i := 0
@@ -1,15 +1,76 @@
 package models
 
+import "strings"
+
 // Function represents the properties of a generated function.
 type Function struct {
-	Name    string          // The name of the function.
-	Options FunctionOptions // The custom options of a function.
-	From    []Type          // The types to copy fields from.
-	To      []Type          // The types to copy fields to.
+	Name       string          // The name of the function.
+	Options    FunctionOptions // The custom options of a function.
+	From       []Type          // The types to copy fields from.
+	To         []Type          // The types to copy fields to.
+	TypeParams []TypeParam     // The type parameters of a generic function (or nil).
+	Error      bool            // Whether the function declares an error result (i.e `func(A) (B, error)`).
+	Context    bool            // Whether the function accepts a context parameter (which isn't a from-type).
+
+	// ContextDefinition represents the full definition of the function's context parameter
+	// (i.e `context.Context`, or `gocontext.Context` when the context package is imported using an alias).
+	ContextDefinition string
 }
 
 // FunctionOptions represent options for a Function.
 type FunctionOptions struct {
-	Custom map[string][]string // The custom options of a function (map[option]values).
-	Manual bool                // Whether the function uses a manual matcher (as opposed to an Automatcher).
+	Custom      map[string][]string // The custom options of a function (map[option]values).
+	Manual      bool                // Whether the function uses a manual matcher (as opposed to an Automatcher).
+	Naming      *NamingOptions      // The naming strategy of the function's automatcher (or nil to use the generator's).
+	Nil         string              // The nil policy of the function.
+	Constructor bool                // Whether the function returns new to-types (instead of assigning to-type parameters).
+}
+
+// TypeParam represents a type parameter of a generic function (i.e `T any`).
+type TypeParam struct {
+	Name       string // The name of the type parameter (i.e `T`).
+	Constraint string // The constraint of the type parameter (i.e `any`).
+}
+
+// ReturnsError determines whether the generated function returns an error:
+// The function declares an error result or uses the error nil policy.
+func (f Function) ReturnsError() bool {
+	return f.Error || f.Options.Nil == NilError
+}
+
+// Converter returns the from-type field that converts a from-field to a to-field (or nil).
+//
+// A converter is a from-type with a function definition that accepts the from-field's
+// definition and returns the to-field's definition (i.e `func(A) B` for an `A` to a `B`).
+//
+// A converter that returns an error (i.e `func(A) (B, error)`) is only used by a function that returns an error,
+// while a converter that accepts a context (i.e `func(context.Context, A) B`) is only used by a function that accepts one.
+func (f Function) Converter(toField, fromField *Field) *Field {
+	if toField == nil || fromField == nil {
+		return nil
+	}
+
+	for _, fromType := range f.From {
+		definition := fromType.Field.FullDefinition()
+		if fromType.Field.AcceptsContext() {
+			if !f.Context {
+				continue
+			}
+
+			// the context is the first parameter, which is removed (i.e `func(context.Context, A) B` to `func(A) B`).
+			definition = CollectionFunc + "(" + definition[strings.Index(definition, ", ")+2:]
+		}
+
+		switch definition {
+		case CollectionFunc + "(" + fromField.FullDefinition() + ") " + toField.FullDefinition():
+			return fromType.Field
+
+		case CollectionFunc + "(" + fromField.FullDefinition() + ") (" + toField.FullDefinition() + ", error)":
+			if f.ReturnsError() {
+				return fromType.Field
+			}
+		}
+	}
+
+	return nil
 }
@@ -12,16 +12,51 @@ type Generator struct {
 
 // GeneratorOptions represents options for a Generator.
 type GeneratorOptions struct {
-	Custom  map[string]interface{} // The custom options of a generator.
-	Matcher MatcherOptions         // The options for the matcher of a generator.
+	Custom      map[string]interface{} // The custom options of a generator.
+	Parser      ParserOptions          // The options for the parser of a generator.
+	Matcher     MatcherOptions         // The options for the matcher of a generator.
+	Nil         string                 // The nil policy of the generated functions (that don't specify one).
+	Constructor bool                   // Whether the generated functions return new to-types (when they don't specify it).
+}
+
+// Nil policies determine how generated code handles a nil pointer that is dereferenced
+// to reference a from-field (i.e `fA.User` in `fA.User.Name`).
+const (
+	NilSkip  = "skip"  // The assignment of the to-field is skipped.
+	NilZero  = "zero"  // The zero value is assigned to the to-field.
+	NilError = "error" // An error is returned (by a function that returns an error).
+)
+
+// IsNilPolicy determines whether a value is a nil policy.
+func IsNilPolicy(value string) bool {
+	return value == NilSkip || value == NilZero || value == NilError
+}
+
+// ParserOptions represents options for the Generator's parser.
+type ParserOptions struct {
+	Custom []string // The option that declares the custom option categories that are allowed (in strict mode).
+	Strict bool     // The option that reports unused options and unknown option categories as errors (instead of warnings).
 }
 
 // MatcherOptions represents options for the Generator's matcher.
 type MatcherOptions struct {
 	CastDepth                    int  // The option that sets the maximum depth for automatic casting.
 	Skip                         bool // The option that skips the matcher.
+	Exhaustive                   bool // The option that requires every to-field to be matched (or ignored).
 	AutoCast                     bool // The option that enables automatic casting.
 	DisableAssignObjectInterface bool // The cast option feature flag that disables assignment of objects to interfaces.
 	DisableAssertInterfaceObject bool // The cast option feature flag that disables assignment of interfaces to objects.
 	DisableConvert               bool // The cast option feature flag that disables type conversion.
+
+	Naming NamingOptions // The option that determines how the automatcher compares the names of fields.
+}
+
+// NamingOptions represents the naming strategy the automatcher uses to compare the names of fields.
+type NamingOptions struct {
+	Insensitive bool     // Whether names are compared case-insensitively (i.e `UserID` and `UserId`).
+	Snake       bool     // Whether snake_case names are compared to camelCase names (i.e `user_id` and `UserID`).
+	Flatten     bool     // Whether the path of a nested field is compared to the name of a flat field (i.e `Address.City` and `AddressCity`).
+	Tag         string   // The struct tag key whose names are also compared (i.e `json` in `json:"user_id"`).
+	Prefixes    []string // The prefixes that are removed from names (i.e `Db`).
+	Suffixes    []string // The suffixes that are removed from names (i.e `Dto`, `Pb`).
 }
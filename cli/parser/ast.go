@@ -42,6 +42,38 @@ func getNodeComments(x ast.Node) []*ast.Comment {
 	return optionComments
 }
 
+// getMethodComments returns a map of the ast.Comments in an *ast.InterfaceType to the name of the method they belong to.
+func getMethodComments(x *ast.InterfaceType) map[*ast.Comment]string {
+	methodComments := make(map[*ast.Comment]string)
+	for _, method := range x.Methods.List {
+		if len(method.Names) == 0 {
+			continue
+		}
+
+		for _, commentGroup := range []*ast.CommentGroup{method.Doc, method.Comment} {
+			if commentGroup == nil {
+				continue
+			}
+
+			for _, comment := range commentGroup.List {
+				methodComments[comment] = method.Names[0].Name
+			}
+		}
+	}
+
+	return methodComments
+}
+
+// position returns the position of a node in the setup file (i.e `setup.go:12:5`)
+// followed by the name of the function it belongs to (when provided).
+func position(fset *token.FileSet, pos token.Pos, function string) string {
+	if function == "" {
+		return fset.Position(pos).String()
+	}
+
+	return fset.Position(pos).String() + ": function " + function
+}
+
 const (
 	newline        = 1
 	carriagereturn = 2
@@ -0,0 +1,148 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+	"golang.org/x/tools/go/packages"
+)
+
+// checkPackages returns the load and type errors of the setup file's package
+// and the packages it imports (which are reported separately).
+//
+// The following errors are NOT returned:
+//   - Errors positioned in the output file, since it's replaced during generation (and may be out of date).
+//   - Errors in an imported package of the output file's directory, since they may be caused by the output file.
+//   - Errors at the parameter names of the `type Copygen interface` (i.e redeclared names), since the generator names parameters.
+func (p *Parser) checkPackages(gen *models.Generator, copygen *ast.InterfaceType) error {
+	outdir := resolvePath(filepath.Dir(absPath(gen.Outpath)))
+	outpath := filepath.Join(outdir, filepath.Base(gen.Outpath))
+
+	ignoredPositions := make(map[string]bool)
+	for _, method := range copygen.Methods.List {
+		signature, ok := method.Type.(*ast.FuncType)
+		if !ok {
+			continue
+		}
+
+		for _, fieldList := range []*ast.FieldList{signature.Params, signature.Results} {
+			if fieldList == nil {
+				continue
+			}
+
+			for _, field := range fieldList.List {
+				for _, name := range field.Names {
+					ignoredPositions[p.Config.SetupPkg.Fset.Position(name.Pos()).String()] = true
+				}
+			}
+		}
+	}
+
+	ignore := func(e packages.Error) bool {
+		return resolvePath(positionFilename(e.Pos)) == outpath || ignoredPositions[e.Pos]
+	}
+
+	var errs []error
+	if setupErrs := packageErrors(p.Config.SetupPkg, ignore); len(setupErrs) != 0 {
+		errs = append(errs, fmt.Errorf("the setup file's package %v contains errors:\n\t%v",
+			p.Config.SetupPkg.PkgPath, strings.Join(setupErrs, "\n\t"),
+		))
+	}
+
+	imports := make([]*packages.Package, 0, len(p.Config.SetupPkg.Imports))
+	for _, imp := range p.Config.SetupPkg.Imports {
+		imports = append(imports, imp)
+	}
+
+	sort.Slice(imports, func(i, j int) bool { return imports[i].PkgPath < imports[j].PkgPath })
+
+	var dependencyErrs []string
+	packages.Visit(imports, nil, func(pkg *packages.Package) {
+		for _, file := range pkg.GoFiles {
+			if filepath.Dir(resolvePath(file)) == outdir {
+				return
+			}
+		}
+
+		for _, e := range packageErrors(pkg, ignore) {
+			dependencyErrs = append(dependencyErrs, pkg.PkgPath+": "+e)
+		}
+	})
+
+	if len(dependencyErrs) != 0 {
+		errs = append(errs, fmt.Errorf("the packages imported by the setup file contain errors:\n\t%v",
+			strings.Join(dependencyErrs, "\n\t"),
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// packageErrors returns the errors of a package that aren't ignored.
+//
+// An error that continues the previous error (i.e `\tother declaration of A`)
+// is reported (or ignored) with the previous error.
+func packageErrors(pkg *packages.Package, ignore func(packages.Error) bool) []string {
+	var errs []string
+	for i := 0; i < len(pkg.Errors); {
+		// determine the error and its continued errors.
+		end := i + 1
+		for end < len(pkg.Errors) && strings.HasPrefix(pkg.Errors[end].Msg, "\t") {
+			end++
+		}
+
+		group := pkg.Errors[i:end]
+		i = end
+
+		ignored := false
+		for _, e := range group {
+			if ignore(e) {
+				ignored = true
+				break
+			}
+		}
+
+		if ignored {
+			continue
+		}
+
+		for _, e := range group {
+			errs = append(errs, e.Error())
+		}
+	}
+
+	return errs
+}
+
+// positionFilename returns the filename of a position (i.e `file:line:col`).
+func positionFilename(pos string) string {
+	for i := 0; i < 2; i++ {
+		index := strings.LastIndex(pos, ":")
+		if index == -1 {
+			break
+		}
+
+		if _, err := strconv.Atoi(pos[index+1:]); err != nil {
+			break
+		}
+
+		pos = pos[:index]
+	}
+
+	return pos
+}
+
+// absPath returns the absolute representation of a filepath (when possible).
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+
+	return path
+}
@@ -11,8 +11,8 @@ import (
 )
 
 // parseField parses a types.Type into a *models.Field recursively.
-func parseField(typ types.Type) *models.Field {
-	if cached, ok := fieldcache[typ.String()]; ok {
+func (p *Parser) parseField(typ types.Type) *models.Field {
+	if cached, ok := p.fieldcache[typ.String()]; ok {
 		return cached
 	}
 
@@ -23,7 +23,7 @@ func parseField(typ types.Type) *models.Field {
 	// https://go.googlesource.com/example/+/HEAD/gotypes#named-types
 	case *types.Named:
 		// set the cache early to prevent issues with named cyclic types.
-		fieldcache[x.String()] = field
+		p.fieldcache[x.String()] = field
 
 		// A named type is either:
 		//   1. an alias (i.e `Placeholder` in `type Placeholder bool`)
@@ -31,17 +31,30 @@ func parseField(typ types.Type) *models.Field {
 		//   3. an interface (i.e `error` in `type error interface`)
 		//   4. a collected type (i.e `domain.Account` in `[]domain.Account`)
 		//
-		// Underlying named types are only important in case 2,
+		// Underlying named types are important in case 2,
 		// when we need to parse extra information from the field.
-		if xs, ok := x.Underlying().(*types.Struct); ok {
-			structfield := parseField(xs)
-			field.Fields = structfield.Fields
-		} else {
-			field.Underlying = parseField(x.Underlying())
+		field.Underlying = p.parseField(x.Underlying())
+		if _, ok := x.Underlying().(*types.Struct); ok {
+			field.Fields = field.Underlying.Fields
 		}
 
-		field.Definition = x.Obj().Name()
+		field.Definition = x.Obj().Name() + p.typeArgsDefinition(x.TypeArgs())
 		setFieldImportAndPackage(field, x.Obj().Pkg())
+		setMethods(field, x)
+
+	// Type Parameters
+	// https://go.dev/ref/spec#Type_parameter_declarations
+	case *types.TypeParam:
+		// A type parameter (i.e `T` in `type Page[T any]`) is referenced by name
+		// and provides the method set of its constraint.
+		field.Definition = x.Obj().Name()
+		setMethods(field, x.Constraint())
+
+	// Alias Types
+	// https://go.dev/ref/spec#Alias_declarations
+	case *types.Alias:
+		// An alias type (i.e `any` in `type any = interface{}`) is parsed as its actual type.
+		return p.parseField(types.Unalias(x))
 
 	// Basic Types
 	// https://go.googlesource.com/example/+/HEAD/gotypes#basic-types
@@ -51,7 +64,7 @@ func parseField(typ types.Type) *models.Field {
 	// Simple Composite Types
 	// https://go.googlesource.com/example/+/HEAD/gotypes#simple-composite-types
 	case *types.Pointer:
-		elemfield := parseField(x.Elem())
+		elemfield := p.parseField(x.Elem())
 
 		// type aliases (including structs) must be deepcopied
 		// in order to match underlying fields.
@@ -60,20 +73,27 @@ func parseField(typ types.Type) *models.Field {
 			field.Fields = deepfield.Fields
 		}
 
-		field.Definition = models.CollectionPointer + collectedDefinition(elemfield)
+		field.Definition = models.CollectionPointer + p.collectedDefinition(elemfield)
 		field.VariableName = "." + alphastring(elemfield.Definition)
+		field.Elem = elemfield
+		setMethods(field, x)
 
 	case *types.Array:
-		field.Definition = "[" + strconv.FormatInt(x.Len(), 10) + "]" + collectedDefinition(parseField(x.Elem()))
+		field.Elem = p.parseField(x.Elem())
+		field.Definition = "[" + strconv.FormatInt(x.Len(), 10) + "]" + p.collectedDefinition(field.Elem)
 
 	case *types.Slice:
-		field.Definition = models.CollectionSlice + collectedDefinition(parseField(x.Elem()))
+		field.Elem = p.parseField(x.Elem())
+		field.Definition = models.CollectionSlice + p.collectedDefinition(field.Elem)
 
 	case *types.Map:
-		field.Definition = models.CollectionMap + "[" + collectedDefinition(parseField(x.Key())) + "]" + collectedDefinition(parseField(x.Elem()))
+		field.Key = p.parseField(x.Key())
+		field.Elem = p.parseField(x.Elem())
+		field.Definition = models.CollectionMap + "[" + p.collectedDefinition(field.Key) + "]" + p.collectedDefinition(field.Elem)
 
 	case *types.Chan:
-		field.Definition = models.CollectionChan + " " + collectedDefinition(parseField(x.Elem()))
+		field.Elem = p.parseField(x.Elem())
+		field.Definition = models.CollectionChan + " " + p.collectedDefinition(field.Elem)
 
 	// Function (without Receivers)
 	// https://go.googlesource.com/example/+/HEAD/gotypes#function-and-method-types
@@ -83,13 +103,16 @@ func parseField(typ types.Type) *models.Field {
 		// set the parameters.
 		definition.WriteString(models.CollectionFunc + "(")
 		for i := 0; i < x.Params().Len(); i++ {
-			definition.WriteString(collectedDefinition(parseField(x.Params().At(i).Type())))
+			definition.WriteString(p.collectedDefinition(p.parseField(x.Params().At(i).Type())))
 			if i+1 != x.Params().Len() {
 				definition.WriteString(", ")
 			}
 		}
 		definition.WriteString(")")
 
+		// a context is determined using its type, since it can be referenced by an alias (i.e `func(gocontext.Context, A) B`).
+		field.Context = x.Params().Len() >= 2 && isContext(x.Params().At(0).Type())
+
 		// set the results.
 		if x.Results().Len() >= 1 {
 			definition.WriteString(" ")
@@ -98,7 +121,7 @@ func parseField(typ types.Type) *models.Field {
 			definition.WriteString("(")
 		}
 		for i := 0; i < x.Results().Len(); i++ {
-			definition.WriteString(collectedDefinition(parseField(x.Results().At(i).Type())))
+			definition.WriteString(p.collectedDefinition(p.parseField(x.Results().At(i).Type())))
 			if i+1 != x.Results().Len() {
 				definition.WriteString(", ")
 			}
@@ -119,17 +142,19 @@ func parseField(typ types.Type) *models.Field {
 			definition.WriteString(models.CollectionInterface + "{")
 
 			for i := 0; i < x.NumMethods(); i++ {
-				definition.WriteString(collectedDefinition(parseField(x.Method(i).Type())) + "; ")
+				definition.WriteString(p.collectedDefinition(p.parseField(x.Method(i).Type())) + "; ")
 			}
 
 			for i := 0; i < x.NumEmbeddeds(); i++ {
-				definition.WriteString(collectedDefinition(parseField(x.EmbeddedType(i))) + "; ")
+				definition.WriteString(p.collectedDefinition(p.parseField(x.EmbeddedType(i))) + "; ")
 			}
 
 			definition.WriteString("}")
 			field.Definition = definition.String()
 		}
 
+		setMethods(field, x)
+
 	// Struct Types
 	// https://go.googlesource.com/example/+/HEAD/gotypes#struct-types
 	case *types.Struct:
@@ -137,7 +162,7 @@ func parseField(typ types.Type) *models.Field {
 		definition.WriteString("struct{")
 		for i := 0; i < x.NumFields(); i++ {
 			// a deepcopy of subfield is returned, then modified.
-			subfield := parseField(x.Field(i).Type()).Deepcopy(nil)
+			subfield := p.parseField(x.Field(i).Type()).Deepcopy(nil)
 			subfield.VariableName = "." + x.Field(i).Name()
 			subfield.Name = x.Field(i).Name()
 			setTags(subfield, x.Tag(i))
@@ -158,7 +183,7 @@ func parseField(typ types.Type) *models.Field {
 			// which are eventually filled.
 			//
 			// cachedsubfield.Fields pointer is never modified.
-			if cachedsubfield, ok := fieldcache[x.Field(i).String()]; ok {
+			if cachedsubfield, ok := p.fieldcache[x.Field(i).String()]; ok {
 				subfield.Fields = cachedsubfield.Fields
 			}
 		}
@@ -172,6 +197,29 @@ func parseField(typ types.Type) *models.Field {
 	return field
 }
 
+// typeArgsDefinition determines the definition of the type arguments
+// of an instantiated generic type (i.e `[models.User]` in `Page[models.User]`).
+//
+// Type arguments are referenced within the instantiated type's definition,
+// so they are defined in the same manner as collected types.
+func (p *Parser) typeArgsDefinition(args *types.TypeList) string {
+	if args.Len() == 0 {
+		return ""
+	}
+
+	var definition strings.Builder
+	definition.WriteString("[")
+	for i := 0; i < args.Len(); i++ {
+		definition.WriteString(p.collectedDefinition(p.parseField(args.At(i))))
+		if i+1 != args.Len() {
+			definition.WriteString(", ")
+		}
+	}
+	definition.WriteString("]")
+
+	return definition.String()
+}
+
 // setFieldImportAndPackage sets the import and package of a field.
 func setFieldImportAndPackage(field *models.Field, pkg *types.Package) {
 	if pkg == nil {
@@ -182,6 +230,27 @@ func setFieldImportAndPackage(field *models.Field, pkg *types.Package) {
 	field.Package = pkg.Name()
 }
 
+// setMethods sets the method set of a field using its type.
+//
+// Methods are represented by their name and signature with fully qualified packages
+// (i.e `String() string`), such that method sets can be compared between fields.
+func setMethods(field *models.Field, typ types.Type) {
+	qualifier := func(pkg *types.Package) string {
+		return pkg.Path()
+	}
+
+	methodset := types.NewMethodSet(typ)
+	if methodset.Len() == 0 {
+		return
+	}
+
+	field.Methods = make([]string, methodset.Len())
+	for i := 0; i < methodset.Len(); i++ {
+		method := methodset.At(i).Obj()
+		field.Methods[i] = method.Name() + strings.TrimPrefix(types.TypeString(method.Type(), qualifier), models.CollectionFunc)
+	}
+}
+
 // setTags sets the tags for a field.
 func setTags(field *models.Field, rawtag string) {
 	// rawtag represents tags as they are defined (i.e `api:"id", json:"tag"`).
@@ -198,28 +267,43 @@ func setTags(field *models.Field, rawtag string) {
 	}
 }
 
+// qualifyPackage determines the package reference of a *types.Package in the generated file.
+//
+// qualifyPackage is used to qualify types that are written using go/types (i.e constraints).
+func (p *Parser) qualifyPackage(pkg *types.Package) string {
+	if pkg.Path() == p.setupPkgPath || (p.outputPkgPath != "" && pkg.Path() == p.outputPkgPath) {
+		return ""
+	}
+
+	if aliasPkg, ok := p.aliasImportMap[pkg.Path()]; ok {
+		return aliasPkg
+	}
+
+	return pkg.Name()
+}
+
 // collectedDefinition determines the full definition for a collected type in a collection.
 //
 // collectedDefinition can be called in the parser, but ONLY because collections are NOT cached.
-func collectedDefinition(collected *models.Field) string {
+func (p *Parser) collectedDefinition(collected *models.Field) string {
 	// a generated file's package == setup file's package.
 	//
 	// when the field is defined in the setup file (i.e `Collection`),
 	// it is parsed with the setup file's package (i.e `copygen.Collection`).
 	//
 	// do NOT reference it by package in the generated file (i.e `Collection`).
-	if collected.Import == setupPkgPath {
+	if collected.Import == p.setupPkgPath {
 		return collected.Definition
 	}
 
 	// when a setup file imports the package it will output to,
 	// do NOT reference the fields defined in the output package, by package.
-	if outputPkgPath != "" && collected.Import == outputPkgPath {
+	if p.outputPkgPath != "" && collected.Import == p.outputPkgPath {
 		return collected.Definition
 	}
 
 	// when a field's import uses an alias, reassign the package reference.
-	if aliasPkg, ok := aliasImportMap[collected.Import]; ok {
+	if aliasPkg, ok := p.aliasImportMap[collected.Import]; ok {
 		return aliasPkg + "." + collected.Definition
 	}
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 
 	"github.com/switchupcb/copygen/cli/models"
@@ -18,58 +19,245 @@ func (p *Parser) parseFunctions(copygen *ast.InterfaceType) ([]models.Function,
 		fmt.Println("WARNING: no functions are defined in the \"type Copygen interface\"")
 	}
 
+	// determine the signatures of convert functions using the setup file's go/types.
+	p.setConvertSignatures()
+
 	// create models.Function objects.
 	functions := make([]models.Function, numMethods)
+	matched := make(map[*options.Option]bool)
+	scoped := make(map[*options.Option]bool)
+	uncallable := make(map[*options.Option]bool)
+	var unused []error
 	for i := 0; i < numMethods; i++ {
 		method := p.Config.SetupPkg.TypesInfo.Defs[copygen.Methods.List[i].Names[0]]
 
 		// create models.Type objects.
-		fieldoptions, manual := getNodeOptions(copygen.Methods.List[i], p.Options.CommentOptionMap)
-		fieldoptions = append(fieldoptions, p.Options.ConvertOptions...)
+		fieldoptions, manual := getNodeOptions(copygen.Methods.List[i], p.Options.CommentOptionMap, p.Config.SetupPkg.Fset, method.Name())
+		numFieldOptions := len(fieldoptions)
 
 		methodFuncs, ok := method.(*types.Func)
 		if !ok {
 			return nil, errors.New("method object is not a Go types function")
 		}
 
-		parsed, err := parseTypes(methodFuncs)
+		parsed, err := p.parseTypes(methodFuncs)
 		if err != nil {
 			return nil, fmt.Errorf("an error occurred while parsing the types of function %q.\n%w", method.Name(), err)
 		}
 
+		// determine the nil policy of the function (or generator).
+		nilPolicy := options.FunctionNil(fieldoptions)
+		if nilPolicy == "" {
+			nilPolicy = p.Options.Nil
+		}
+
+		// convert options are only applied to the functions that their function regex matches
+		// and that can call their convert function.
+		for _, option := range p.Options.ConvertOptions {
+			if !options.IsFunctionMatched(method.Name(), *option) {
+				continue
+			}
+
+			scoped[option] = true
+			if err := uncallableOption(option, method.Name(), parsed.error || nilPolicy == models.NilError, parsed.context != ""); err != nil {
+				unused = append(unused, err)
+				uncallable[option] = true
+				continue
+			}
+
+			fieldoptions = append(fieldoptions, option)
+		}
+
 		// set the options for each field.
-		setTypeOptions(parsed.fromTypes, fieldoptions)
-		setTypeOptions(parsed.toTypes, fieldoptions)
+		setTypeOptions(parsed.fromTypes, fieldoptions, matched)
+		setTypeOptions(parsed.toTypes, fieldoptions, matched)
+		p.resolveCastTo(parsed.fromTypes, parsed.toTypes)
+
+		// determine the function's options that are never matched to a field.
+		for _, option := range fieldoptions[:numFieldOptions] {
+			if options.IsFieldOptionCategory(option.Category) && !isMatchedOption(option, matched, parsed.toTypes) {
+				unused = append(unused, unusedOption(option, "field", options.Describe(option)))
+			}
+		}
 
 		// map the function custom options.
 		customoptionmap := make(map[string][]string)
 		for _, option := range fieldoptions {
 			customoptionmap, err = options.MapCustomOption(customoptionmap, option)
 			if err != nil {
-				fmt.Printf("WARNING: %v\n", err)
+				fmt.Printf("WARNING: %v: %v\n", option.Position, err)
 			}
 		}
 
+		// determine whether the function (or generator) returns new to-types.
+		constructor := p.Options.Constructor
+		if functionConstructor := options.FunctionConstructor(fieldoptions); functionConstructor != nil {
+			constructor = *functionConstructor
+		}
+
 		// create the models.Function object.
 		function := models.Function{
-			Name: method.Name(),
-			To:   parsed.toTypes,
-			From: parsed.fromTypes,
+			Name:              method.Name(),
+			To:                parsed.toTypes,
+			From:              parsed.fromTypes,
+			TypeParams:        parsed.typeParams,
+			Error:             parsed.error,
+			Context:           parsed.context != "",
+			ContextDefinition: parsed.context,
 			Options: models.FunctionOptions{
-				Custom: customoptionmap,
-				Manual: manual,
+				Custom:      customoptionmap,
+				Manual:      manual,
+				Naming:      options.FunctionNaming(fieldoptions),
+				Nil:         nilPolicy,
+				Constructor: constructor,
 			},
 		}
 
 		functions[i] = function
 	}
 
+	// determine the convert options that are never applied to a function or matched to a field (in any function).
+	for _, option := range p.Options.ConvertOptions {
+		switch {
+		case !scoped[option]:
+			unused = append(unused, unusedOption(option, "function", options.DescribeFunction(option)))
+
+		case !matched[option] && !uncallable[option]:
+			unused = append(unused, unusedOption(option, "field", options.Describe(option)))
+		}
+	}
+
+	if err := p.reportUnusedOptions(unused); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
 	return functions, nil
 }
 
+// isMatchedOption determines whether an option is matched to a field.
+//
+// A map (or cast) option is only matched when it matches a from-field to an existing to-field.
+func isMatchedOption(option *options.Option, matched map[*options.Option]bool, toTypes []models.Type) bool {
+	if !matched[option] {
+		return false
+	}
+
+	switch option.Category {
+	case options.CategoryMap:
+		return findField(toTypes, option.Value.(string)) != nil
+
+	case options.CategoryCast:
+		return findField(toTypes, option.Value.([]string)[0]) != nil
+	}
+
+	return true
+}
+
+// findField returns the field of the given types with the given full name (or nil).
+func findField(types []models.Type, name string) *models.Field {
+	for _, t := range types {
+		for _, field := range t.Field.AllFields(nil, nil) {
+			if field.IsFullName(name) {
+				return field
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveCastTo resolves the to-field that each from-field's cast option applies to.
+//
+// A cast option that doesn't match an existing to-field is removed from the from-field.
+func (p *Parser) resolveCastTo(fromTypes, toTypes []models.Type) {
+	for _, t := range fromTypes {
+		for _, field := range t.Field.AllFields(nil, nil) {
+			if field.Options.CastTo == "" {
+				continue
+			}
+
+			if toField := findField(toTypes, field.Options.CastTo); toField != nil {
+				p.castTo[field] = toField
+				continue
+			}
+
+			field.Options.CastTo = ""
+			field.Options.Cast = ""
+			field.Options.CastDepth = nil
+		}
+	}
+}
+
+// setConvertSignatures sets whether the convert function of each convert option
+// returns an error or accepts a context, which is determined once the setup file is type-checked.
+func (p *Parser) setConvertSignatures() {
+	for _, option := range p.Options.ConvertOptions {
+		convert, ok := option.Value.(options.Convert)
+		if !ok {
+			continue
+		}
+
+		function, ok := p.Config.SetupPkg.Types.Scope().Lookup(convert.Function).(*types.Func)
+		if !ok {
+			continue
+		}
+
+		signature := function.Type().(*types.Signature)
+		convert.Error = signature.Results().Len() == 2 && isError(signature.Results().At(1).Type())
+		convert.Context = signature.Params().Len() >= 2 && isContext(signature.Params().At(0).Type())
+		option.Value = convert
+	}
+}
+
+// uncallableOption returns the error of a convert option when a function can't call its convert function (or nil).
+//
+// A convert function that returns an error is only called by a function that returns an error,
+// while a convert function that accepts a context is only called by a function that accepts one.
+func uncallableOption(option *options.Option, function string, returnsError, acceptsContext bool) error {
+	convert, ok := option.Value.(options.Convert)
+	switch {
+	case !ok:
+		return nil
+
+	case convert.Error && !returnsError:
+		return unusedOption(option, "function that returns an error", function)
+
+	case convert.Context && !acceptsContext:
+		return unusedOption(option, "function that accepts a context", function)
+	}
+
+	return nil
+}
+
+// unusedOption returns the error of an option that doesn't match a field (or function).
+func unusedOption(option *options.Option, target, description string) error {
+	// ignore options were previously custom options (i.e `ignore true`), which are now ignore regex.
+	if option.Category == options.CategoryIgnore {
+		description += " (ignore is a built-in option, so a custom ignore option must be renamed)"
+	}
+
+	return fmt.Errorf("%v: the %v option does not match a %v: %v", option.Position, option.Category, target, description)
+}
+
+// reportUnusedOptions reports the errors of options that are never matched to a field (or function)
+// as warnings (or errors in strict mode).
+func (p *Parser) reportUnusedOptions(unused []error) error {
+	if p.Options.Strict {
+		return errors.Join(unused...)
+	}
+
+	for _, err := range unused {
+		fmt.Printf("WARNING: %v\n", err)
+	}
+
+	return nil
+}
+
 // getNodeOptions gets an ast.Node options from its comments.
 // To reduce overhead, it also returns whether a manual matcher is used.
-func getNodeOptions(x ast.Node, commentoptionmap map[string]*options.Option) ([]*options.Option, bool) {
+//
+// Each option is a copy of the parsed option, positioned at the comment of the given function.
+func getNodeOptions(x ast.Node, commentoptionmap map[string]*options.Option, fset *token.FileSet, function string) ([]*options.Option, bool) {
 	nodeOptions := make([]*options.Option, 0, len(commentoptionmap))
 	var manual bool
 
@@ -81,10 +269,12 @@ func getNodeOptions(x ast.Node, commentoptionmap map[string]*options.Option) ([]
 
 		for _, comment := range commentGroup.List {
 			if commentoptionmap[comment.Text] != nil {
-				nodeOptions = append(nodeOptions, commentoptionmap[comment.Text])
+				option := *commentoptionmap[comment.Text]
+				option.Position = position(fset, comment.Pos(), function)
+				nodeOptions = append(nodeOptions, &option)
 
 				// specifying a match option disables automatching by default.
-				if options.IsMatchOptionCategory(commentoptionmap[comment.Text].Category) {
+				if options.IsMatchOptionCategory(option.Category) {
 					manual = true
 				}
 			}
@@ -96,10 +286,17 @@ func getNodeOptions(x ast.Node, commentoptionmap map[string]*options.Option) ([]
 	return nodeOptions, manual
 }
 
-// setTypeOptions sets the options for all fields in the given types.
-func setTypeOptions(types []models.Type, fieldoptions []*options.Option) {
+// setTypeOptions sets the options for all fields in the given types
+// and marks the options that are matched to a field.
+func setTypeOptions(types []models.Type, fieldoptions []*options.Option, matched map[*options.Option]bool) {
 	for _, t := range types {
 		for _, field := range t.Field.AllFields(nil, nil) {
+			for _, option := range fieldoptions {
+				if options.IsFieldMatched(field, *option) {
+					matched[option] = true
+				}
+			}
+
 			options.SetFieldOptions(field, fieldoptions)
 			options.FilterDepth(field, field.Options.Depth, 0)
 		}
@@ -21,7 +21,7 @@ func (p *Parser) Keep(astFile *ast.File) error {
 		case *ast.GenDecl:
 
 			// keep all declaration objects in the setup file except for the `type Copygen interface`.
-			if _, ok := assertCopygenInterface(declaration); ok {
+			if it, ok := assertCopygenInterface(declaration); ok {
 				foundCopygenInterface = true
 
 				// remove from the `type Copygen interface` (from the slice).
@@ -30,7 +30,7 @@ func (p *Parser) Keep(astFile *ast.File) error {
 
 				// remove the `type Copygen interface` function ast.Comments.
 				comments := getNodeComments(declaration)
-				if err := p.assignFieldOption(comments); err != nil {
+				if err := p.assignFieldOption(comments, getMethodComments(it)); err != nil {
 					return fmt.Errorf("%w", err)
 				}
 				trash = append(trash, comments...)
@@ -59,7 +59,9 @@ func (p *Parser) Keep(astFile *ast.File) error {
 
 // assignFieldOption parses a list of ast.Comments into options
 // and places them in a map[text]Option.
-func (p *Parser) assignFieldOption(comments []*ast.Comment) error {
+//
+// methodComments maps the ast.Comments to the name of the method they belong to.
+func (p *Parser) assignFieldOption(comments []*ast.Comment, methodComments map[*ast.Comment]string) error {
 	if p.Options.CommentOptionMap == nil {
 		p.Options.CommentOptionMap = make(map[string]*options.Option, len(comments))
 	}
@@ -81,11 +83,22 @@ func (p *Parser) assignFieldOption(comments []*ast.Comment) error {
 			}
 
 			optiontext := strings.Join(splitcomments[1:], " ")
+			pos := position(p.Config.Fileset, comment.Pos(), methodComments[comment])
+
+			// the comments of the `type Copygen interface` declaration (as opposed to its methods) are NOT checked.
+			if _, ok := methodComments[comment]; ok {
+				if err := p.checkCategory(category); err != nil {
+					return fmt.Errorf("%v: %w", pos, err)
+				}
+			}
+
 			option, err := options.NewFieldOption(category, optiontext)
 			if err != nil {
-				return fmt.Errorf("%w", err)
+				return fmt.Errorf("%v: %w", pos, err)
 			}
 
+			option.Position = pos
+
 			p.Options.CommentOptionMap[text] = option
 		}
 	}
@@ -116,12 +129,15 @@ func (p *Parser) assignConvertOptions(x *ast.FuncDecl) ([]*ast.Comment, error) {
 				category := splitcomments[0]
 				value := strings.Join(splitcomments[1:], " ")
 				if category == options.CategoryConvert {
-					option, err := options.ParseConvert(value, x.Name.Name)
+					pos := position(p.Config.Fileset, comment.Pos(), x.Name.Name)
+					option, err := options.ParseConvert(value, options.Convert{Function: x.Name.Name})
 					if err != nil {
-						assignErr = err
+						assignErr = fmt.Errorf("%v: %w", pos, err)
 						return false
 					}
 
+					option.Position = pos
+
 					p.Options.ConvertOptions = append(p.Options.ConvertOptions, option)
 					convertComments = append(convertComments, comment)
 				}
@@ -133,3 +149,21 @@ func (p *Parser) assignConvertOptions(x *ast.FuncDecl) ([]*ast.Comment, error) {
 
 	return convertComments, assignErr
 }
+
+// checkCategory returns an error when an option category is unknown in strict mode:
+// A category is known when it's built-in or declared as a custom option category.
+func (p *Parser) checkCategory(category string) error {
+	if !p.Options.Strict && len(p.Options.Custom) == 0 {
+		return nil
+	}
+
+	if !options.IsCustomCategory(category) || p.Options.Custom[category] {
+		return nil
+	}
+
+	if suggestion := options.SuggestCategory(category); suggestion != "" {
+		return fmt.Errorf("there is an unknown option category: %q.\nDid you mean %q?", category, suggestion)
+	}
+
+	return fmt.Errorf("there is an unknown option category: %q.\nIs it declared as a custom option category in the .yml file?", category)
+}
@@ -3,6 +3,7 @@ package options
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/switchupcb/copygen/cli/models"
@@ -24,7 +25,7 @@ const (
 func ParseCast(option string) (*Option, error) {
 	splitoption := strings.Fields(option)
 	if len(splitoption) == 0 {
-		return nil, fmt.Errorf("there is an unspecified %s option at an unknown line", CategoryCast)
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryCast)
 	} else if len(splitoption) < 2 {
 		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryCast, option, FormatCast)
 	}
@@ -34,27 +35,86 @@ func ParseCast(option string) (*Option, error) {
 		return nil, fmt.Errorf("an error occurred compiling the regex for the from-field in the %s option: %q\n%w", CategoryCast, option, err)
 	}
 
+	// cast options are compared in the matcher.
 	return &Option{
 		Category: CategoryCast,
 		Regex:    map[int]*regexp.Regexp{0: fromRe},
-		Value:    []string{splitoption[1], strings.Join(splitoption[2:], " ")}, // []string{from-field, modifier}
+		Value:    []string{splitoption[1], strings.Join(splitoption[2:], " ")}, // []string{to-field, modifier}
 	}, nil
 }
 
 // SetCast sets a field's cast option.
 func SetCast(field *models.Field, option Option) {
+	// A cast option can only be set to a field once.
+	if field.Options.CastTo != "" {
+		return
+	}
+
 	if option.Regex[0] != nil && option.Regex[0].MatchString(field.FullNameWithoutPointer("")) {
 		if value, ok := option.Value.([]string); ok {
-			field.Options.Cast = "(" + value[0] + ")" + value[1]
+			field.Options.CastTo = value[0]
+			setCastModifier(field, value[1])
 		}
 	}
 }
 
+// setCastModifier sets a field's cast modifier.
+//
+// An integer modifier sets the depth the field is casted at,
+// while any other modifier is applied to the field during assignment.
+func setCastModifier(field *models.Field, modifier string) {
+	if modifier == "" {
+		return
+	}
+
+	if depth, err := strconv.Atoi(modifier); err == nil {
+		// Casting is disabled when a user specifies a 0 depth-level; guarantee it.
+		if depth < 0 {
+			depth = 0
+		}
+
+		field.Options.CastDepth = &depth
+
+		return
+	}
+
+	field.Options.Cast = modifier
+}
+
+// ModifierCast represents the option modifier that enables casting for an option.
+const ModifierCast = "-" + CategoryCast
+
+// cutModifierCast cuts a cast option modifier from an option's text,
+// then returns the text before and after the modifier, and whether it's found.
+func cutModifierCast(option string) (string, string, bool) {
+	splitoption := strings.Fields(option)
+	for i, field := range splitoption {
+		if field == ModifierCast {
+			return strings.Join(splitoption[:i], " "), strings.Join(splitoption[i+1:], " "), true
+		}
+	}
+
+	return option, "", false
+}
+
 // ParseModifierCast parses a cast option modifier.
 func ParseModifierCast(option string) (*Option, error) {
-	fmt.Printf("FEATURE TODO: ParseModifierCast: option %q", option)
+	splitoption := strings.Fields(option)
+	if _, _, ok := cutModifierCast(option); ok {
+		return nil, fmt.Errorf("there is a misconfigured %s option modifier: %q.\nIs it in format %s?", CategoryCast, option, FormatModifierCast)
+	}
 
-	// - cast modifier...
+	return &Option{
+		Category: CategoryCast,
+		Regex:    nil,
+		Value:    strings.Join(splitoption, " "), // string
+	}, nil
+}
 
-	return nil, nil //nolint:nilnil
+// SetModifierCast sets a field's cast option using a cast option modifier.
+func SetModifierCast(field *models.Field, option Option) {
+	if value, ok := option.Value.(string); ok {
+		field.Options.AutoCast = true
+		setCastModifier(field, value)
+	}
 }
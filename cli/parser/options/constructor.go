@@ -0,0 +1,47 @@
+package options
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	CategoryConstructor = "constructor"
+
+	// FormatConstructor represents an end-user facing format for constructor options.
+	FormatConstructor = "<option><whitespaces><bool>"
+)
+
+// ParseConstructor parses a constructor option.
+func ParseConstructor(option string) (*Option, error) {
+	value := strings.TrimSpace(option)
+	if value == "" {
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryConstructor)
+	}
+
+	constructor, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryConstructor, option, FormatConstructor)
+	}
+
+	return &Option{
+		Category: CategoryConstructor,
+		Regex:    nil,
+		Value:    constructor, // bool
+	}, nil
+}
+
+// FunctionConstructor returns whether a function's options generate a function that returns new to-types (or nil).
+func FunctionConstructor(functionoptions []*Option) *bool {
+	var constructor *bool
+	for _, option := range functionoptions {
+		if option.Category == CategoryConstructor {
+			if value, ok := option.Value.(bool); ok {
+				constructor = &value
+			}
+		}
+	}
+
+	return constructor
+}
@@ -16,11 +16,21 @@ const (
 	FormatConvert = "<option><whitespaces><regex><whitespaces><regex>"
 )
 
-// ParseConvert parses a convert option.
-func ParseConvert(option, value string) (*Option, error) {
+// Convert represents the value of a convert option.
+//
+// Convert options are parsed before the setup file is type-checked,
+// so the signature of the convert function is set afterwards.
+type Convert struct {
+	Function string // The name of the convert function.
+	Error    bool   // Whether the convert function returns an error (i.e `func(A) (B, error)`).
+	Context  bool   // Whether the convert function accepts a context (i.e `func(context.Context, A) B`).
+}
+
+// ParseConvert parses a convert option for a convert function.
+func ParseConvert(option string, convert Convert) (*Option, error) {
 	splitoption := strings.Fields(option)
 	if len(splitoption) == 0 {
-		return nil, fmt.Errorf("there is an unspecified %s option at an unknown line", CategoryConvert)
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryConvert)
 	} else if len(splitoption) != 2 {
 		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryConvert, option, FormatConvert)
 	}
@@ -38,7 +48,7 @@ func ParseConvert(option, value string) (*Option, error) {
 	return &Option{
 		Category: CategoryConvert,
 		Regex:    map[int]*regexp.Regexp{0: funcRe, 1: fieldRe},
-		Value:    value, // string
+		Value:    convert, // Convert
 	}, nil
 }
 
@@ -50,8 +60,10 @@ func SetConvert(field *models.Field, option Option) {
 	}
 
 	if option.Regex[1] != nil && option.Regex[1].MatchString(field.FullNameWithoutPointer("")) {
-		if value, ok := option.Value.(string); ok {
-			field.Options.Convert = value
+		if value, ok := option.Value.(Convert); ok {
+			field.Options.Convert = value.Function
+			field.Options.ConvertError = value.Error
+			field.Options.ConvertContext = value.Context
 		}
 	}
 }
@@ -9,6 +9,80 @@ const (
 	FormatCustom   = "<option><whitespaces><value>"
 )
 
+// categories represents the categories of built-in options.
+var categories = []string{
+	CategoryAutomatch,
+	CategoryMap,
+	CategoryTag,
+	CategoryCast,
+	CategoryDepth,
+	CategoryDeepcopy,
+	CategoryIgnore,
+	CategoryNaming,
+	CategoryNil,
+	CategoryConstructor,
+	CategoryConvert,
+}
+
+// IsCustomCategory determines whether an option category is custom (as opposed to built-in).
+func IsCustomCategory(category string) bool {
+	for _, c := range categories {
+		if category == c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// maxSuggestionDistance represents the maximum edit distance between
+// an unknown option category and a suggested built-in category.
+const maxSuggestionDistance = 2
+
+// SuggestCategory returns the built-in option category that is closest to an unknown category
+// (or "" when no category is close).
+func SuggestCategory(category string) string {
+	suggestion, distance := "", maxSuggestionDistance+1
+	for _, c := range categories {
+		if d := editDistance(category, c); d < distance {
+			suggestion, distance = c, d
+		}
+	}
+
+	return suggestion
+}
+
+// editDistance returns the amount of insertions, deletions, substitutions,
+// and transpositions (of adjacent characters) that transform one string into another.
+func editDistance(a, b string) int {
+	// d[i][j] represents the distance between a[:i] and b[:j].
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
 // MapCustomOption maps a custom option in an optionmap[category][]values.
 func MapCustomOption(optionmap map[string][]string, option *Option) (map[string][]string, error) {
 	if optionmap == nil {
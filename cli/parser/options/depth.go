@@ -21,7 +21,7 @@ const (
 func ParseDepth(option string) (*Option, error) {
 	splitoption := strings.Fields(option)
 	if len(splitoption) == 0 {
-		return nil, fmt.Errorf("there is an unspecified %s option at an unknown line", CategoryDepth)
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryDepth)
 	} else if len(splitoption) != 2 {
 		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryDepth, option, FormatDepth)
 	}
@@ -0,0 +1,43 @@
+package options
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+const (
+	CategoryIgnore = "ignore"
+	FormatIgnore   = "<option><whitespaces><regex>"
+)
+
+// ParseIgnore parses an ignore option.
+func ParseIgnore(option string) (*Option, error) {
+	if option == "" {
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryIgnore)
+	}
+
+	re, err := regexp.Compile("^" + option + "$")
+	if err != nil {
+		return nil, fmt.Errorf("an error occurred compiling the regex for an %s option: %q\n%w", CategoryIgnore, option, err)
+	}
+
+	return &Option{
+		Category: CategoryIgnore,
+		Regex:    map[int]*regexp.Regexp{0: re},
+		Value:    true, // bool
+	}, nil
+}
+
+// SetIgnore sets a field's ignore option.
+func SetIgnore(field *models.Field, option Option) {
+	// An ignore option can only be set to a field once.
+	if field.Options.Ignore {
+		return
+	}
+
+	if option.Regex[0] != nil && option.Regex[0].MatchString(field.FullNameWithoutPointer("")) {
+		field.Options.Ignore = true
+	}
+}
@@ -18,6 +18,13 @@ func IsMatchOptionSet(field models.Field) bool {
 	return field.Options.Automatch || field.Options.Map != "" || field.Options.Tag != ""
 }
 
+// setOptionModifiers sets the option modifiers of a match option to a field.
+func setOptionModifiers(field *models.Field, option Option) {
+	if option.Cast != nil {
+		SetModifierCast(field, *option.Cast)
+	}
+}
+
 const (
 	CategoryAutomatch = "automatch"
 
@@ -30,7 +37,7 @@ const (
 func ParseAutomatch(option string) (*Option, error) {
 	splitoption := strings.Fields(option)
 	if len(splitoption) == 0 {
-		return nil, fmt.Errorf("there is an unspecified %s option at an unknown line", CategoryAutomatch)
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryAutomatch)
 	} else if len(splitoption) != 1 {
 		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryAutomatch, option, FormatAutomatch)
 	}
@@ -55,6 +62,7 @@ func SetAutomatch(field *models.Field, option Option) {
 
 	if option.Regex[0] != nil && option.Regex[0].MatchString(field.FullNameWithoutPointer("")) {
 		field.Options.Automatch = true
+		setOptionModifiers(field, option)
 	}
 }
 
@@ -70,7 +78,7 @@ const (
 func ParseMap(option string) (*Option, error) {
 	splitoption := strings.Fields(option)
 	if len(splitoption) == 0 {
-		return nil, fmt.Errorf("there is an unspecified %s option at an unknown line", CategoryMap)
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryMap)
 	} else if len(splitoption) != 2 {
 		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryMap, option, FormatMap)
 	}
@@ -97,6 +105,7 @@ func SetMap(field *models.Field, option Option) {
 	if option.Regex[0] != nil && option.Regex[0].MatchString(field.FullNameWithoutPointer("")) {
 		if value, ok := option.Value.(string); ok {
 			field.Options.Map = value
+			setOptionModifiers(field, option)
 		}
 	}
 }
@@ -113,7 +122,7 @@ const (
 func ParseTag(option string) (*Option, error) {
 	splitoption := strings.Fields(option)
 	if len(splitoption) == 0 {
-		return nil, fmt.Errorf("there is an unspecified %s option at an unknown line", CategoryTag)
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryTag)
 	} else if len(splitoption) != 2 {
 		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryTag, option, FormatTag)
 	}
@@ -148,6 +157,7 @@ func SetTag(field *models.Field, option Option) {
 					// i.e `api:id` in `api:"id", api:"name"` (invalid).
 					for tagname := range tagmeta {
 						field.Options.Tag = tagcat + ":" + tagname
+						setOptionModifiers(field, option)
 						return
 					}
 				}
@@ -0,0 +1,71 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+const (
+	CategoryNaming = "naming"
+
+	// FormatNaming represents an end-user facing format for naming options.
+	// <strategy> refers to `insensitive`, `snake`, `flatten`, `tag:<key>`, `prefix:<prefix>`, or `suffix:<suffix>`.
+	FormatNaming = "<option><whitespaces><strategy><whitespaces><strategy>..."
+)
+
+// ParseNaming parses a naming option.
+func ParseNaming(option string) (*Option, error) {
+	splitoption := strings.Fields(option)
+	if len(splitoption) == 0 {
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryNaming)
+	}
+
+	var naming models.NamingOptions
+	for _, strategy := range splitoption {
+		key, value, _ := strings.Cut(strategy, ":")
+		switch {
+		case strategy == "insensitive":
+			naming.Insensitive = true
+
+		case strategy == "snake":
+			naming.Snake = true
+
+		case strategy == "flatten":
+			naming.Flatten = true
+
+		case key == "tag" && value != "":
+			naming.Tag = value
+
+		case key == "prefix" && value != "":
+			naming.Prefixes = append(naming.Prefixes, value)
+
+		case key == "suffix" && value != "":
+			naming.Suffixes = append(naming.Suffixes, value)
+
+		default:
+			return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryNaming, option, FormatNaming)
+		}
+	}
+
+	return &Option{
+		Category: CategoryNaming,
+		Regex:    nil,
+		Value:    naming, // models.NamingOptions
+	}, nil
+}
+
+// FunctionNaming returns the naming strategy of a function's options (or nil).
+func FunctionNaming(functionoptions []*Option) *models.NamingOptions {
+	var naming *models.NamingOptions
+	for _, option := range functionoptions {
+		if option.Category == CategoryNaming {
+			if value, ok := option.Value.(models.NamingOptions); ok {
+				naming = &value
+			}
+		}
+	}
+
+	return naming
+}
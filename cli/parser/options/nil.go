@@ -0,0 +1,48 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+const (
+	CategoryNil = "nil"
+
+	// FormatNil represents an end-user facing format for nil options.
+	// <policy> refers to `skip`, `zero`, or `error`.
+	FormatNil = "<option><whitespaces><policy>"
+)
+
+// ParseNil parses a nil option.
+func ParseNil(option string) (*Option, error) {
+	policy := strings.TrimSpace(option)
+	if policy == "" {
+		return nil, fmt.Errorf("there is an unspecified %s option", CategoryNil)
+	}
+
+	if !models.IsNilPolicy(policy) {
+		return nil, fmt.Errorf("there is a misconfigured %s option: %q.\nIs it in format %s?", CategoryNil, option, FormatNil)
+	}
+
+	return &Option{
+		Category: CategoryNil,
+		Regex:    nil,
+		Value:    policy, // string
+	}, nil
+}
+
+// FunctionNil returns the nil policy of a function's options (or "").
+func FunctionNil(functionoptions []*Option) string {
+	var policy string
+	for _, option := range functionoptions {
+		if option.Category == CategoryNil {
+			if value, ok := option.Value.(string); ok {
+				policy = value
+			}
+		}
+	}
+
+	return policy
+}
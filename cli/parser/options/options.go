@@ -3,6 +3,7 @@ package options
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/switchupcb/copygen/cli/models"
 )
@@ -17,6 +18,13 @@ type Option struct {
 
 	// The category the option falls under.
 	Category string
+
+	// The cast option modifier applied with the option (or nil).
+	Cast *Option
+
+	// The position of the option in the setup file and the function it belongs to
+	// (i.e `setup.go:12:5: function ModelsToDomain`).
+	Position string
 }
 
 // NewFieldOption creates a new field-oriented option from the given category and text.
@@ -24,15 +32,21 @@ func NewFieldOption(category, text string) (*Option, error) {
 	var option *Option
 	var err error
 
+	// option modifiers are only applied to match options.
+	optiontext, modifier, cast := text, "", false
+	if IsMatchOptionCategory(category) {
+		optiontext, modifier, cast = cutModifierCast(text)
+	}
+
 	switch category {
 	case CategoryAutomatch:
-		option, err = ParseAutomatch(text)
+		option, err = ParseAutomatch(optiontext)
 
 	case CategoryMap:
-		option, err = ParseMap(text)
+		option, err = ParseMap(optiontext)
 
 	case CategoryTag:
-		option, err = ParseTag(text)
+		option, err = ParseTag(optiontext)
 
 	case CategoryCast:
 		option, err = ParseCast(text)
@@ -43,6 +57,18 @@ func NewFieldOption(category, text string) (*Option, error) {
 	case CategoryDepth:
 		option, err = ParseDepth(text)
 
+	case CategoryIgnore:
+		option, err = ParseIgnore(text)
+
+	case CategoryNaming:
+		option, err = ParseNaming(text)
+
+	case CategoryNil:
+		option, err = ParseNil(text)
+
+	case CategoryConstructor:
+		option, err = ParseConstructor(text)
+
 	default:
 		option = &Option{
 			Category: CategoryCustom,
@@ -54,9 +80,89 @@ func NewFieldOption(category, text string) (*Option, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if cast {
+		if option.Cast, err = ParseModifierCast(modifier); err != nil {
+			return nil, err
+		}
+	}
+
 	return option, nil
 }
 
+// IsFieldOptionCategory determines whether an option category applies to fields
+// (as opposed to functions).
+func IsFieldOptionCategory(category string) bool {
+	switch category {
+	case CategoryCustom, CategoryNaming, CategoryNil, CategoryConstructor:
+		return false
+	}
+
+	return true
+}
+
+// IsFieldMatched determines whether the regex of an option matches a field,
+// such that the option can be set to the field.
+//
+// Custom options are NOT matched to fields.
+func IsFieldMatched(field *models.Field, option Option) bool {
+	var regex *regexp.Regexp
+	switch option.Category {
+	case CategoryAutomatch, CategoryMap, CategoryTag, CategoryCast, CategoryDepth, CategoryDeepcopy, CategoryIgnore:
+		regex = option.Regex[0]
+
+	case CategoryConvert:
+		regex = option.Regex[1]
+	}
+
+	return regex != nil && regex.MatchString(field.FullNameWithoutPointer(""))
+}
+
+// IsFunctionMatched determines whether the function regex of an option matches a function,
+// such that the option can be applied to the function's fields.
+//
+// Options that aren't scoped to functions (using regex) are matched to every function.
+func IsFunctionMatched(function string, option Option) bool {
+	if option.Category != CategoryConvert {
+		return true
+	}
+
+	return option.Regex[0] != nil && option.Regex[0].MatchString(function)
+}
+
+// DescribeFunction returns the argument of an option that's matched to functions (i.e `ModelsToDomain`).
+func DescribeFunction(option *Option) string {
+	if option.Category != CategoryConvert {
+		return ""
+	}
+
+	return regexText(option.Regex[0])
+}
+
+// Describe returns the arguments of an option that are matched to fields (i.e `.* models.User.ID`).
+func Describe(option *Option) string {
+	switch option.Category {
+	case CategoryMap:
+		return regexText(option.Regex[0]) + " " + option.Value.(string)
+
+	case CategoryCast:
+		return regexText(option.Regex[0]) + " " + option.Value.([]string)[0]
+
+	case CategoryConvert:
+		return regexText(option.Regex[1])
+
+	case CategoryCustom, CategoryNaming, CategoryNil, CategoryConstructor:
+		return ""
+	}
+
+	return regexText(option.Regex[0])
+}
+
+// regexText returns the text of an option's regex (without the anchors added during compilation).
+func regexText(regex *regexp.Regexp) string {
+	return strings.TrimSuffix(strings.TrimPrefix(regex.String(), "^"), "$")
+}
+
 // SetFieldOptions sets a field's options.
 func SetFieldOptions(field *models.Field, fieldoptions []*Option) {
 	for _, option := range fieldoptions {
@@ -81,8 +187,8 @@ func SetFieldOptions(field *models.Field, fieldoptions []*Option) {
 		case CategoryDeepcopy:
 			SetDeepcopy(field, *option)
 
-		case CategoryCustom:
-			SetConvert(field, *option)
+		case CategoryIgnore:
+			SetIgnore(field, *option)
 
 		case CategoryConvert:
 			SetConvert(field, *option)
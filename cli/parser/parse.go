@@ -9,6 +9,7 @@ import (
 	"go/parser"
 	"go/printer"
 	"go/token"
+	"path/filepath"
 
 	"github.com/switchupcb/copygen/cli/models"
 	"github.com/switchupcb/copygen/cli/parser/options"
@@ -16,41 +17,19 @@ import (
 )
 
 // Parser represents a parser that parses Abstract Syntax Tree data into models.
+//
+// A Parser's state is scoped to the setup file it parses,
+// such that multiple parsers can be used concurrently.
 type Parser struct {
 	Config  Config
 	Options Options
 	Pkgs    []*packages.Package
-}
-
-// Config represents a Parser's configuration.
-type Config struct {
-	// SetupFile represents the setup file as an Abstract Syntax Tree.
-	SetupFile *ast.File
-
-	// SetupPkg represent the setup file's package.
-	SetupPkg *packages.Package
-
-	// Fileset represents the parser's fileset.
-	Fileset *token.FileSet
-}
 
-// Options represents a parser's options.
-type Options struct {
-	// commentOptionMap represents a map of comments (as text) to an option.
-	CommentOptionMap map[string]*options.Option
-
-	// convertOptions represents a global list of convert options (for convert functions).
-	ConvertOptions []*options.Option
-}
-
-// GLOBAL VARIABLES.
-var (
 	// fieldcache represents a map of `go/types` Type strings to models.Field.
 	//
 	// fieldcache is used to prevent cyclic fields from incorrect assignment.
 	//
-	// fieldcache improves performance by parsing a unique type definition once per runtime.
-	// definitions remain constant UNLESS the user modifies their modules during runtime.
+	// fieldcache improves performance by parsing a unique type definition once per setup file.
 	fieldcache map[string]*models.Field
 
 	// setupPkgPath represents the current path of the setup file's package.
@@ -82,27 +61,172 @@ var (
 	// aliasImportMap is referenced while parsing collected type definitions for collection fields,
 	// and while setting package references for non-collection fields after parsing.
 	aliasImportMap map[string]string
-)
 
-// SetupCache sets up the parser's global cache.
-func SetupCache() {
-	if fieldcache == nil {
-		fieldcache = make(map[string]*models.Field)
-	}
+	// castTo represents a map of from-fields to the to-field their cast option applies to.
+	//
+	// castTo is used to reference the to-field of a cast option by its name in the generated file,
+	// since the to-field is referenced by its name in the setup file (i.e `domain.Account.ID`)
+	// while parsing options and by its renamed package after parsing.
+	castTo map[*models.Field]*models.Field
+}
+
+// Config represents a Parser's configuration.
+type Config struct {
+	// SetupFile represents the setup file as an Abstract Syntax Tree.
+	SetupFile *ast.File
+
+	// SetupPkg represent the setup file's package.
+	SetupPkg *packages.Package
+
+	// Fileset represents the parser's fileset.
+	Fileset *token.FileSet
 }
 
-// ResetCache resets the parser's global cache.
-func ResetCache() {
-	fieldcache = make(map[string]*models.Field)
+// Options represents a parser's options.
+type Options struct {
+	// commentOptionMap represents a map of comments (as text) to an option.
+	CommentOptionMap map[string]*options.Option
+
+	// convertOptions represents a global list of convert options (for convert functions).
+	ConvertOptions []*options.Option
+
+	// Strict represents whether unused options and unknown option categories are reported as errors.
+	Strict bool
+
+	// Custom represents the custom option categories that are allowed in strict mode.
+	//
+	// Declaring a custom option category enables strict mode for unknown option categories.
+	Custom map[string]bool
+
+	// Nil represents the nil policy of functions that don't specify one (using a nil option).
+	Nil string
+
+	// Constructor represents whether functions that don't specify one (using a constructor option)
+	// return new to-types.
+	Constructor bool
 }
 
 // parserLoadMode represents the load mode required for sufficient information during package load.
-const parserLoadMode = packages.NeedName + packages.NeedImports + packages.NeedDeps + packages.NeedTypes + packages.NeedSyntax + packages.NeedTypesInfo
+const parserLoadMode = packages.NeedName + packages.NeedFiles + packages.NeedImports + packages.NeedDeps + packages.NeedTypes + packages.NeedSyntax + packages.NeedTypesInfo
 
 // Parse parses a generator's setup file.
 func Parse(gen *models.Generator) error {
+	pkgs, err := LoadPackages(gen)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return ParsePackage(gen, pkgs[gen.Setpath], pkgs[gen.Outpath])
+}
+
+// LoadPackages loads the packages of the generators' setup files and output files using a single packages.Load call.
+//
+// LoadPackages returns a map of each generator's setup filepath and output filepath
+// to the package of the file (when it's loaded). An output file that doesn't exist yet is NOT loaded.
+func LoadPackages(gens ...*models.Generator) (map[string]*packages.Package, error) {
+	patterns := make([]string, 0, len(gens)*2)
+	for _, gen := range gens {
+		patterns = append(patterns, "file="+gen.Setpath, "file="+gen.Outpath)
+	}
+
+	cfg := &packages.Config{Mode: parserLoadMode}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the packages for types.\n%w", err)
+	}
+
+	files := make(map[string]*packages.Package)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			files[resolvePath(file)] = pkg
+		}
+	}
+
+	// a file that could not be loaded is not mapped to a package.
+	filePkgs := make(map[string]*packages.Package, len(gens)*2)
+	for _, gen := range gens {
+		for _, path := range []string{gen.Setpath, gen.Outpath} {
+			if pkg, ok := files[resolvePath(path)]; ok {
+				filePkgs[path] = pkg
+			}
+		}
+	}
+
+	return filePkgs, nil
+}
+
+// dependencyLoadMode represents the load mode required to determine the files of packages (and their imports).
+const dependencyLoadMode = packages.NeedName + packages.NeedFiles + packages.NeedImports + packages.NeedDeps + packages.NeedModule
+
+// DependencyFiles returns the Go files that the generators' setup files depend on:
+// The files of each setup file's package and the packages it imports (excluding the standard library).
+func DependencyFiles(gens ...*models.Generator) ([]string, error) {
+	patterns := make([]string, len(gens))
+	for i, gen := range gens {
+		patterns[i] = "file=" + gen.Setpath
+	}
+
+	cfg := &packages.Config{Mode: dependencyLoadMode}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("an error occurred while loading the packages for files.\n%w", err)
+	}
+
+	var files []string
+	visited := make(map[*packages.Package]bool)
+	addFiles := func(pkg *packages.Package) {
+		if visited[pkg] {
+			return
+		}
+
+		visited[pkg] = true
+		files = append(files, pkg.GoFiles...)
+	}
+
+	for _, pkg := range pkgs {
+		addFiles(pkg)
+
+		// packages of the standard library are not contained in a module.
+		for _, imp := range pkg.Imports {
+			if imp.Module != nil {
+				addFiles(imp)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// resolvePath returns a filepath with its symbolic links evaluated (when possible).
+func resolvePath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+
+	return path
+}
+
+// ParsePackage parses a generator's setup file using its loaded package
+// and the loaded package of its output file (or nil when the output file doesn't exist).
+//
+// ParsePackage is safe to call concurrently for generators that share loaded packages.
+func ParsePackage(gen *models.Generator, setupPkg, outputPkg *packages.Package) error {
+	if setupPkg == nil {
+		return fmt.Errorf("the package of the specified .go setup file could not be loaded: %v", gen.Setpath)
+	}
+
 	var err error
-	p := new(Parser)
+	p := &Parser{
+		fieldcache: make(map[string]*models.Field),
+		castTo:     make(map[*models.Field]*models.Field),
+	}
+	p.Options.Strict = gen.Options.Parser.Strict
+	p.Options.Nil = gen.Options.Nil
+	p.Options.Constructor = gen.Options.Constructor
+	p.Options.Custom = make(map[string]bool, len(gen.Options.Parser.Custom))
+	for _, category := range gen.Options.Parser.Custom {
+		p.Options.Custom[category] = true
+	}
 	p.Config.Fileset = token.NewFileSet()
 	p.Config.SetupFile, err = parser.ParseFile(p.Config.Fileset, gen.Setpath, nil, parser.ParseComments)
 	if err != nil {
@@ -115,25 +239,20 @@ func Parse(gen *models.Generator) error {
 	}
 
 	// Analyze a new `type Copygen Interface` to create models.Function and models.Field objects.
-	cfg := &packages.Config{Mode: parserLoadMode}
-	p.Pkgs, err = packages.Load(cfg, "file="+gen.Setpath)
-	if err != nil {
-		return fmt.Errorf("an error occurred while loading the packages for types.\n%w", err)
-	}
-	p.Config.SetupPkg = p.Pkgs[0]
-	setupPkgPath = p.Config.SetupPkg.PkgPath
+	p.Pkgs = []*packages.Package{setupPkg}
+	p.Config.SetupPkg = setupPkg
+	p.setupPkgPath = p.Config.SetupPkg.PkgPath
 
 	// determine the output file package path.
-	outputPkgs, _ := packages.Load(&packages.Config{Mode: packages.NeedName}, "file="+gen.Outpath)
-	if len(outputPkgs) > 0 {
-		outputPkgPath = outputPkgs[0].PkgPath
+	if outputPkg != nil {
+		p.outputPkgPath = outputPkg.PkgPath
 	}
 
 	// set the aliasImportMap.
-	aliasImportMap = make(map[string]string, len(p.Config.SetupFile.Imports))
+	p.aliasImportMap = make(map[string]string, len(p.Config.SetupFile.Imports))
 	for _, imp := range p.Config.SetupFile.Imports {
 		if imp.Name != nil {
-			aliasImportMap[imp.Path.Value[1:len(imp.Path.Value)-1]] = imp.Name.Name
+			p.aliasImportMap[imp.Path.Value[1:len(imp.Path.Value)-1]] = imp.Name.Name
 		}
 	}
 
@@ -162,14 +281,21 @@ func Parse(gen *models.Generator) error {
 		return errors.New("the \"type Copygen interface\" could not be found (in the setup file's go/types)")
 	}
 
+	// report load and type errors before the setup file is parsed.
+	if err := p.checkPackages(gen, newCopygen); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
 	// create models.Function objects.
-	SetupCache()
 	if gen.Functions, err = p.parseFunctions(newCopygen); err != nil {
 		return fmt.Errorf("%w", err)
 	}
 
 	// rename non-collection fields' packages using imports.
-	setPackages(gen)
+	p.setPackages(gen)
+
+	// rename the to-fields of cast options using their renamed packages.
+	p.setCastTo()
 
 	// Write the Keep.
 	buf := new(bytes.Buffer)
@@ -179,16 +305,12 @@ func Parse(gen *models.Generator) error {
 	}
 	gen.Keep = buf.Bytes()
 
-	// reset global variables.
-	setupPkgPath = ""
-	outputPkgPath = ""
-	aliasImportMap = nil
-
 	return nil
 }
 
 // setPackages sets the packages for all fields in a generator using names from the setup file.
-func setPackages(gen *models.Generator) {
+func (p *Parser) setPackages(gen *models.Generator) {
+	cyclic := make(map[*models.Field]bool)
 	for _, function := range gen.Functions {
 		functionTypes := [][]models.Type{
 			function.From,
@@ -197,33 +319,60 @@ func setPackages(gen *models.Generator) {
 
 		for _, types := range functionTypes {
 			for _, t := range types {
-				for _, field := range t.Field.AllFields(nil, nil) {
-
-					// a generated file's package == setup file's package.
-					//
-					// when the field is defined in the setup file (i.e `Collection`),
-					// it is parsed with the setup file's package (i.e `copygen.Collection`).
-					//
-					// do NOT reference it by package in the generated file (i.e `Collection`).
-					if field.Import == setupPkgPath {
-						field.Package = ""
-						continue
-					}
-
-					// when a setup file imports the package it will output to,
-					// do NOT reference the fields defined in the output package, by package.
-					if outputPkgPath != "" && field.Import == outputPkgPath {
-						field.Package = ""
-						continue
-					}
-
-					// when a field's import uses an alias, reassign the package reference.
-					if aliasPkg, ok := aliasImportMap[field.Import]; ok {
-						field.Package = aliasPkg
-						continue
-					}
-				}
+				p.setFieldPackages(t.Field, cyclic)
 			}
 		}
 	}
 }
+
+// setCastTo sets the to-field name of each from-field's cast option using the to-field's renamed package.
+func (p *Parser) setCastTo() {
+	for fromField, toField := range p.castTo {
+		fromField.Options.CastTo = toField.FullNameWithoutPointer("")
+	}
+}
+
+// setFieldPackages sets the packages for a field and the fields it references
+// (including its underlying, element, and key fields).
+func (p *Parser) setFieldPackages(field *models.Field, cyclic map[*models.Field]bool) {
+	if field == nil || cyclic[field] {
+		return
+	}
+
+	cyclic[field] = true
+	p.setPackage(field)
+
+	for _, subfield := range field.Fields {
+		p.setFieldPackages(subfield, cyclic)
+	}
+
+	p.setFieldPackages(field.Underlying, cyclic)
+	p.setFieldPackages(field.Elem, cyclic)
+	p.setFieldPackages(field.Key, cyclic)
+}
+
+// setPackage sets the package for a field using names from the setup file.
+func (p *Parser) setPackage(field *models.Field) {
+	// a generated file's package == setup file's package.
+	//
+	// when the field is defined in the setup file (i.e `Collection`),
+	// it is parsed with the setup file's package (i.e `copygen.Collection`).
+	//
+	// do NOT reference it by package in the generated file (i.e `Collection`).
+	if field.Import == p.setupPkgPath {
+		field.Package = ""
+		return
+	}
+
+	// when a setup file imports the package it will output to,
+	// do NOT reference the fields defined in the output package, by package.
+	if p.outputPkgPath != "" && field.Import == p.outputPkgPath {
+		field.Package = ""
+		return
+	}
+
+	// when a field's import uses an alias, reassign the package reference.
+	if aliasPkg, ok := p.aliasImportMap[field.Import]; ok {
+		field.Package = aliasPkg
+	}
+}
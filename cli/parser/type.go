@@ -3,18 +3,25 @@ package parser
 import (
 	"errors"
 	"go/types"
+	"sort"
 	"strconv"
 
 	"github.com/switchupcb/copygen/cli/models"
 )
 
 type parsedTypes struct {
-	fromTypes []models.Type
-	toTypes   []models.Type
+	fromTypes  []models.Type
+	toTypes    []models.Type
+	typeParams []models.TypeParam
+	error      bool
+	context    string
 }
 
 // parseTypes parses a types.Func's parameters for from-types and results for to-types.
-func parseTypes(method *types.Func) (parsedTypes, error) {
+//
+// A context parameter (i.e `context.Context`) is NOT parsed as a from-type,
+// and a trailing error result (i.e `func(A) (B, error)`) is NOT parsed as a to-type.
+func (p *Parser) parseTypes(method *types.Func) (parsedTypes, error) {
 	var result parsedTypes
 
 	signature, ok := method.Type().(*types.Signature)
@@ -22,20 +29,128 @@ func parseTypes(method *types.Func) (parsedTypes, error) {
 		return result, errors.New("impossible")
 	}
 
-	result.fromTypes = parseTypeField(signature.Params())
+	params := signature.Params()
+	for i, fromType := range p.parseTypeField(params) {
+		// a context is qualified in the same manner as collected types (i.e `gocontext.Context`).
+		if isContext(params.At(i).Type()) {
+			result.context = p.collectedDefinition(fromType.Field)
+			continue
+		}
+
+		result.fromTypes = append(result.fromTypes, fromType)
+	}
 	setVariableNames(result.fromTypes, "f")
 
-	result.toTypes = parseTypeField(signature.Results())
+	result.toTypes = p.parseTypeField(signature.Results())
+	if results := signature.Results(); results.Len() != 0 && isError(results.At(results.Len()-1).Type()) {
+		result.toTypes = result.toTypes[:len(result.toTypes)-1]
+		result.error = true
+	}
 	setVariableNames(result.toTypes, "t")
 
+	result.typeParams = p.parseTypeParams(signature)
+
 	return result, nil
 }
 
+// isError determines whether a type is the predeclared error type.
+func isError(typ types.Type) bool {
+	return types.Identical(typ, types.Universe.Lookup("error").Type())
+}
+
+// isContext determines whether a type is a context (i.e `context.Context`).
+func isContext(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
+
+// parseTypeParams parses the type parameters that are referenced in a *types.Signature.
+//
+// A generic `type Copygen[A any, B any] interface` declares type parameters for its methods,
+// such that each function is generated with the type parameters it references (in order).
+func (p *Parser) parseTypeParams(signature *types.Signature) []models.TypeParam {
+	referenced := make(map[*types.TypeParam]bool)
+	collectTypeParams(signature, referenced, make(map[types.Type]bool))
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	typeParams := make([]*types.TypeParam, 0, len(referenced))
+	for typeParam := range referenced {
+		typeParams = append(typeParams, typeParam)
+	}
+
+	sort.Slice(typeParams, func(i, j int) bool {
+		return typeParams[i].Index() < typeParams[j].Index()
+	})
+
+	parsed := make([]models.TypeParam, len(typeParams))
+	for i, typeParam := range typeParams {
+		parsed[i] = models.TypeParam{
+			Name:       typeParam.Obj().Name(),
+			Constraint: types.TypeString(typeParam.Constraint(), p.qualifyPackage),
+		}
+	}
+
+	return parsed
+}
+
+// collectTypeParams collects the type parameters that are referenced in a types.Type.
+func collectTypeParams(typ types.Type, referenced map[*types.TypeParam]bool, visited map[types.Type]bool) {
+	if visited[typ] {
+		return
+	}
+
+	visited[typ] = true
+	switch x := typ.(type) {
+	case *types.TypeParam:
+		referenced[x] = true
+
+	case *types.Alias:
+		collectTypeParams(types.Unalias(x), referenced, visited)
+
+	case *types.Named:
+		for i := 0; i < x.TypeArgs().Len(); i++ {
+			collectTypeParams(x.TypeArgs().At(i), referenced, visited)
+		}
+
+	case *types.Pointer:
+		collectTypeParams(x.Elem(), referenced, visited)
+
+	case *types.Array:
+		collectTypeParams(x.Elem(), referenced, visited)
+
+	case *types.Slice:
+		collectTypeParams(x.Elem(), referenced, visited)
+
+	case *types.Map:
+		collectTypeParams(x.Key(), referenced, visited)
+		collectTypeParams(x.Elem(), referenced, visited)
+
+	case *types.Chan:
+		collectTypeParams(x.Elem(), referenced, visited)
+
+	case *types.Signature:
+		collectTypeParams(x.Params(), referenced, visited)
+		collectTypeParams(x.Results(), referenced, visited)
+
+	case *types.Tuple:
+		for i := 0; i < x.Len(); i++ {
+			collectTypeParams(x.At(i).Type(), referenced, visited)
+		}
+
+	case *types.Struct:
+		for i := 0; i < x.NumFields(); i++ {
+			collectTypeParams(x.Field(i).Type(), referenced, visited)
+		}
+	}
+}
+
 // parseTypeField parses a *types.Tuple into a *models.Type (that points to a *models.Field).
-func parseTypeField(vars *types.Tuple) []models.Type {
+func (p *Parser) parseTypeField(vars *types.Tuple) []models.Type {
 	types := make([]models.Type, vars.Len())
 	for i := 0; i < vars.Len(); i++ {
-		field := parseField(vars.At(i).Type()).Deepcopy(nil)
+		field := p.parseField(vars.At(i).Type()).Deepcopy(nil)
 		field.Name = vars.At(i).Name()
 
 		if !field.IsPointer() {
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/switchupcb/copygen/cli/config"
+	"github.com/switchupcb/copygen/cli/models"
+	"github.com/switchupcb/copygen/cli/parser"
+)
+
+const (
+	// watchInterval represents the interval at which watched files are checked for changes.
+	watchInterval = 250 * time.Millisecond
+
+	// watchDebounce represents the duration without changes that must pass
+	// before code is regenerated, such that a burst of changes results in one run.
+	watchDebounce = 500 * time.Millisecond
+)
+
+// RunWatch runs copygen programmatically using the given Environment's YML, YMLPath, or Pattern,
+// then runs it again when a watched file changes until the stop channel is closed.
+//
+// The watched files include the .yml, setup, and template files of each configuration file,
+// and the Go files of the packages that each setup file imports (except output files).
+// A run does NOT reuse a parser (or its cache) from a previous run.
+func (e *Environment) RunWatch(stop <-chan struct{}) error {
+	for {
+		// the states of the watched files are saved before a run,
+		// such that a change during the run is detected after it.
+		watched, err := e.watchFiles()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "WARNING: %v\n", err)
+		}
+
+		states := snapshot(watched)
+
+		if err := e.runOnce(); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		} else {
+			fmt.Printf("copygen: generated code at %v\n", time.Now().Format(time.TimeOnly))
+		}
+
+		if stopped := waitForChange(watched, states, stop); stopped {
+			return nil
+		}
+	}
+}
+
+// runOnce runs copygen using the given Environment's YML, YMLPath, or Pattern.
+func (e *Environment) runOnce() error {
+	if e.Pattern != "" {
+		_, err := e.RunPattern()
+		return err
+	}
+
+	_, err := e.Run()
+	return err
+}
+
+// watchedFiles represents the files that are watched for changes.
+type watchedFiles struct {
+	// files represents the watched files and directories.
+	files []string
+
+	// outputs represents the output files, which are NOT watched
+	// (including as the entries of watched directories).
+	outputs map[string]bool
+}
+
+// watchFiles returns the files that are watched for changes.
+func (e *Environment) watchFiles() (watchedFiles, error) {
+	watched := watchedFiles{outputs: make(map[string]bool)}
+
+	var ymlpaths []string
+	switch {
+	case e.Pattern != "":
+		var err error
+		if ymlpaths, err = config.FindYML(e.Pattern); err != nil {
+			return watched, fmt.Errorf("%w", err)
+		}
+
+	case e.YML == nil:
+		ymlpaths = []string{e.YMLPath}
+	}
+
+	// a .yml file is watched, even when it can't be loaded.
+	gens := make([]*models.Generator, 0, len(ymlpaths))
+	for _, ymlpath := range ymlpaths {
+		watched.files = append(watched.files, ymlpath)
+
+		if gen, err := config.LoadYML(ymlpath); err == nil {
+			gens = append(gens, gen)
+		}
+	}
+
+	// a configuration provided without a .yml file is watched using its generator.
+	if e.YML != nil {
+		gen, err := e.loadGenerator()
+		if err != nil {
+			return watched, fmt.Errorf("%w", err)
+		}
+
+		gens = append(gens, gen)
+	}
+
+	for _, gen := range gens {
+		watched.files = append(watched.files, gen.Setpath)
+		if gen.Tempath != "" {
+			watched.files = append(watched.files, gen.Tempath)
+		}
+
+		// an output file is written by a run, which must not trigger another run.
+		watched.outputs[filepath.Clean(gen.Outpath)] = true
+	}
+
+	if len(gens) == 0 {
+		return watched, nil
+	}
+
+	dependencies, err := parser.DependencyFiles(gens...)
+	if err != nil {
+		return watched, fmt.Errorf("%w", err)
+	}
+
+	// the directories of files are watched for added and removed files.
+	directories := make(map[string]bool)
+	for _, dependency := range dependencies {
+		if watched.outputs[filepath.Clean(dependency)] {
+			continue
+		}
+
+		watched.files = append(watched.files, dependency)
+		directories[filepath.Dir(dependency)] = true
+	}
+
+	for directory := range directories {
+		watched.files = append(watched.files, directory)
+	}
+
+	return watched, nil
+}
+
+// fileState represents the state of a watched file.
+type fileState struct {
+	modified time.Time
+	size     int64
+	exists   bool
+
+	// entries represents the names of a directory's entries (except output files).
+	entries string
+}
+
+// snapshot returns the state of every watched file.
+//
+// A directory's state is represented by its entries, since the directory is modified
+// when an output file is created in it.
+func snapshot(watched watchedFiles) map[string]fileState {
+	states := make(map[string]fileState, len(watched.files))
+	for _, file := range watched.files {
+		info, err := os.Stat(file)
+		if err != nil {
+			states[file] = fileState{}
+			continue
+		}
+
+		if !info.IsDir() {
+			states[file] = fileState{modified: info.ModTime(), size: info.Size(), exists: true}
+			continue
+		}
+
+		var entries strings.Builder
+		if dirEntries, err := os.ReadDir(file); err == nil {
+			for _, entry := range dirEntries {
+				if !watched.outputs[filepath.Join(file, entry.Name())] {
+					entries.WriteString(entry.Name() + "\n")
+				}
+			}
+		}
+
+		states[file] = fileState{exists: true, entries: entries.String()}
+	}
+
+	return states
+}
+
+// changed determines whether the state of any file has changed.
+func changed(previous, current map[string]fileState) bool {
+	for file, state := range current {
+		if previous[file] != state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// waitForChange waits until a watched file changes from its saved state (and no further changes occur
+// for the debounce duration) or the stop channel is closed, which returns true.
+func waitForChange(watched watchedFiles, states map[string]fileState, stop <-chan struct{}) bool {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var lastChange time.Time
+	for {
+		select {
+		case <-stop:
+			return true
+
+		case now := <-ticker.C:
+			current := snapshot(watched)
+			if changed(states, current) {
+				states = current
+				lastChange = now
+				continue
+			}
+
+			if !lastChange.IsZero() && now.Sub(lastChange) >= watchDebounce {
+				return false
+			}
+		}
+	}
+}
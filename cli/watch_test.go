@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestSnapshotChanged tests whether changes to the state of watched files
+// (created, modified, deleted, and directory entries) are detected, except for output files.
+func TestSnapshotChanged(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "setup.go")
+	output := filepath.Join(dir, "copygen.go")
+	files := watchedFiles{
+		files:   []string{file, dir},
+		outputs: map[string]bool{output: true},
+	}
+
+	states := snapshot(files)
+	if states[file].exists {
+		t.Fatalf("snapshot(%q) got an existing state for a file that doesn't exist", file)
+	}
+
+	if changed(states, snapshot(files)) {
+		t.Fatalf("changed() detected a change without changing a file")
+	}
+
+	steps := []struct {
+		name   string
+		change func() error
+	}{
+		{
+			name:   "create",
+			change: func() error { return os.WriteFile(file, []byte("package copygen\n"), 0o600) },
+		},
+		{
+			name:   "modify",
+			change: func() error { return os.WriteFile(file, []byte("package copygen\n\n// modified\n"), 0o600) },
+		},
+		{
+			name:   "delete",
+			change: func() error { return os.Remove(file) },
+		},
+		{
+			name:   "directory entry",
+			change: func() error { return os.WriteFile(filepath.Join(dir, "added.go"), nil, 0o600) },
+		},
+	}
+
+	for _, step := range steps {
+		// ensure that the modification time of a change differs on file systems with a coarse resolution.
+		time.Sleep(10 * time.Millisecond)
+
+		if err := step.change(); err != nil {
+			t.Fatalf("error in step %q.\n%v", step.name, err)
+		}
+
+		current := snapshot(files)
+		if !changed(states, current) {
+			t.Fatalf("changed() did not detect the change in step %q", step.name)
+		}
+
+		states = current
+	}
+
+	if states[file].exists {
+		t.Fatalf("snapshot(%q) got an existing state for a deleted file", file)
+	}
+
+	// an output file is written by a run, which isn't a change.
+	outputSteps := []struct {
+		name   string
+		change func() error
+	}{
+		{
+			name:   "create output",
+			change: func() error { return os.WriteFile(output, []byte("package copygen\n"), 0o600) },
+		},
+		{
+			name:   "modify output",
+			change: func() error { return os.WriteFile(output, []byte("package copygen\n\n// modified\n"), 0o600) },
+		},
+	}
+
+	for _, step := range outputSteps {
+		time.Sleep(10 * time.Millisecond)
+
+		if err := step.change(); err != nil {
+			t.Fatalf("error in step %q.\n%v", step.name, err)
+		}
+
+		if changed(states, snapshot(files)) {
+			t.Fatalf("changed() detected a change in step %q", step.name)
+		}
+	}
+}
+
+// TestWaitForChange tests whether waitForChange returns after a change (and the debounce duration),
+// including a change that occurs before it's called, and when the stop channel is closed.
+func TestWaitForChange(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "setup.go")
+	if err := os.WriteFile(file, []byte("package copygen\n"), 0o600); err != nil {
+		t.Fatalf("error writing file.\n%v", err)
+	}
+
+	files := watchedFiles{files: []string{file}}
+
+	go func() {
+		time.Sleep(2 * watchInterval)
+		if err := os.WriteFile(file, []byte("package copygen\n\n// modified\n"), 0o600); err != nil {
+			t.Errorf("error modifying file.\n%v", err)
+		}
+	}()
+
+	start := time.Now()
+	if stopped := waitForChange(files, snapshot(files), make(chan struct{})); stopped {
+		t.Fatalf("waitForChange() returned true without closing the stop channel")
+	}
+
+	if elapsed := time.Since(start); elapsed < 2*watchInterval+watchDebounce {
+		t.Fatalf("waitForChange() returned after %v, before the debounce duration passed", elapsed)
+	}
+
+	// a change that occurs before waitForChange is called (i.e during a run) is detected using the saved states.
+	states := snapshot(files)
+	time.Sleep(10 * time.Millisecond)
+	if err := os.WriteFile(file, []byte("package copygen\n\n// modified during a run\n"), 0o600); err != nil {
+		t.Fatalf("error modifying file.\n%v", err)
+	}
+
+	if stopped := waitForChange(files, states, make(chan struct{})); stopped {
+		t.Fatalf("waitForChange() returned true without closing the stop channel")
+	}
+
+	stop := make(chan struct{})
+	go func() {
+		time.Sleep(watchInterval)
+		close(stop)
+	}()
+
+	if stopped := waitForChange(files, snapshot(files), stop); !stopped {
+		t.Fatalf("waitForChange() returned false after closing the stop channel")
+	}
+}
@@ -0,0 +1,23 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/allocation/domain"
+	"github.com/switchupcb/copygen/examples/_tests/allocation/models"
+)
+
+// ModelsToDomain copies a *models.User to a *domain.Account.
+func ModelsToDomain(tA *domain.Account, fU *models.User) {
+	// *domain.Account fields
+	if tA.User == nil {
+		tA.User = new(domain.User)
+	}
+	tA.User.UserID = fU.UserID
+	if tA.User.Profile == nil {
+		tA.User.Profile = new(domain.Profile)
+	}
+	tA.User.Profile.Name = fU.Name
+}
@@ -0,0 +1,19 @@
+// Package domain contains business logic models.
+package domain
+
+// Account represents a user's account.
+type Account struct {
+	ID   int
+	User *User
+}
+
+// User represents a user.
+type User struct {
+	UserID  int
+	Profile *Profile
+}
+
+// Profile represents the profile of a user.
+type Profile struct {
+	Name string
+}
@@ -0,0 +1,8 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// User represents a user.
+type User struct {
+	UserID int
+	Name   string
+}
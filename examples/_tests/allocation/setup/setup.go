@@ -0,0 +1,12 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/allocation/domain"
+	"github.com/switchupcb/copygen/examples/_tests/allocation/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	ModelsToDomain(*models.User) *domain.Account
+}
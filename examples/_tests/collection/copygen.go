@@ -0,0 +1,54 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/collection/domain"
+	"github.com/switchupcb/copygen/examples/_tests/collection/models"
+)
+
+// TeamToDomain copies a *models.Team to a *domain.Team.
+func TeamToDomain(tT *domain.Team, fT *models.Team) {
+	// *domain.Team fields
+	tT.Name = fT.Name
+	if fT.Users != nil {
+		tT.Users = make([]*domain.User, len(fT.Users))
+		for i := range fT.Users {
+			tT.Users[i] = new(domain.User)
+			UserToDomain(tT.Users[i], &fT.Users[i])
+		}
+	}
+	if fT.Members != nil {
+		tT.Members = make(map[string]domain.User, len(fT.Members))
+		for k, v := range fT.Members {
+			var c domain.User
+			if v != nil {
+				UserToDomain(&c, v)
+			}
+			tT.Members[k] = c
+		}
+	}
+	for i := range fT.Accounts {
+		tT.Accounts[i] = new(domain.Account)
+		tT.Accounts[i].ID = fT.Accounts[i].ID
+		tT.Accounts[i].Email = fT.Accounts[i].Email
+	}
+	if fT.Leads != nil {
+		tT.Leads = make([]domain.Account, len(fT.Leads))
+		for i := range fT.Leads {
+			if fT.Leads[i] != nil {
+				tT.Leads[i].ID = fT.Leads[i].ID
+				tT.Leads[i].Email = fT.Leads[i].Email
+			}
+		}
+	}
+}
+
+// UserToDomain copies a *models.User to a *domain.User.
+func UserToDomain(tU *domain.User, fU *models.User) {
+	// *domain.User fields
+	tU.ID = fU.ID
+	tU.Name = fU.Name
+}
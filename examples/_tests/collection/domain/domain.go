@@ -0,0 +1,24 @@
+// Package domain contains business logic models.
+package domain
+
+// Team represents a team of users.
+type Team struct {
+	Name     string
+	Users    []*User
+	Members  map[string]User
+	Accounts [2]*Account
+	Leads    []Account
+}
+
+// User represents a user.
+type User struct {
+	ID   int
+	Name string
+}
+
+// Account represents a user's account.
+type Account struct {
+	ID       int
+	Email    string
+	Verified bool
+}
@@ -0,0 +1,13 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/collection/domain"
+	"github.com/switchupcb/copygen/examples/_tests/collection/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	TeamToDomain(*models.Team) *domain.Team
+	UserToDomain(*models.User) *domain.User
+}
@@ -0,0 +1,65 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/switchupcb/copygen/examples/_tests/constructor/domain"
+	"github.com/switchupcb/copygen/examples/_tests/constructor/models"
+)
+
+// AccountToDomain copies a *models.Account to a domain.Account.
+func AccountToDomain(fA *models.Account) (domain.Account, error) {
+	// domain.Account fields
+	var tA domain.Account
+	tA.ID = fA.ID
+	tA.User = UserToDomain(&fA.User)
+	if fA.Friends == nil {
+		return domain.Account{}, errors.New("models.Account.Friends is nil")
+	}
+	tA.Friends = make([]*domain.User, len(fA.Friends))
+	for i := range fA.Friends {
+		tA.Friends[i] = UserToDomain(&fA.Friends[i])
+	}
+	if fA.Profile == nil {
+		return domain.Account{}, errors.New("models.Account.Profile is nil")
+	}
+	copiedProfile, err := ProfileToDomain(fA.Profile)
+	if err != nil {
+		return domain.Account{}, fmt.Errorf("models.Account.Profile: %w", err)
+	}
+	tA.Profile = *copiedProfile
+	return tA, nil
+}
+
+// UserToDomain copies a *models.User to a *domain.User.
+func UserToDomain(fU *models.User) *domain.User {
+	// *domain.User fields
+	tU := &domain.User{
+		ID:   fU.ID,
+		Name: fU.Name,
+	}
+	return tU
+}
+
+// ProfileToDomain copies a *models.Profile to a *domain.Profile.
+func ProfileToDomain(fP *models.Profile) (*domain.Profile, error) {
+	// *domain.Profile fields
+	tP := new(domain.Profile)
+	if fP.Bio == nil {
+		return nil, errors.New("models.Profile.Bio is nil")
+	}
+	tP.Bio = *fP.Bio
+	return tP, nil
+}
+
+// CopyUser copies a *models.User to a *domain.User.
+func CopyUser(tU *domain.User, fU *models.User) {
+	// *domain.User fields
+	tU.ID = fU.ID
+	tU.Name = fU.Name
+}
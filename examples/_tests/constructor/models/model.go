@@ -0,0 +1,21 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	User    User
+	Friends []User
+	Profile *Profile
+}
+
+// User represents a user.
+type User struct {
+	ID   int
+	Name string
+}
+
+// Profile represents the profile of a user.
+type Profile struct {
+	Bio *string
+}
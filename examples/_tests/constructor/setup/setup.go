@@ -0,0 +1,21 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/constructor/domain"
+	"github.com/switchupcb/copygen/examples/_tests/constructor/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	// nil error
+	AccountToDomain(*models.Account) domain.Account
+
+	UserToDomain(*models.User) *domain.User
+
+	// nil error
+	ProfileToDomain(*models.Profile) *domain.Profile
+
+	// constructor false
+	CopyUser(*models.User) *domain.User
+}
@@ -0,0 +1,65 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/switchupcb/copygen/examples/_tests/context/domain"
+	"github.com/switchupcb/copygen/examples/_tests/context/models"
+)
+
+// Localize localizes a name using the locale of a context.
+func Localize(ctx context.Context, name string) string {
+	if locale, ok := ctx.Value("locale").(string); ok {
+		return name + " (" + locale + ")"
+	}
+
+	return name
+}
+
+// Exchange exchanges a balance (until the context is canceled).
+func Exchange(ctx context.Context, balance int) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// AccountToDomain copies a *models.Account, func(context.Context, time.Time) string to a *domain.Account.
+func AccountToDomain(ctx context.Context, tA *domain.Account, fA *models.Account, ff func(context.Context, time.Time) string) error {
+	// *domain.Account fields
+	tA.ID = fA.ID
+	convertedBalance, err := Exchange(ctx, fA.Balance)
+	if err != nil {
+		return fmt.Errorf("models.Account.Balance: %w", err)
+	}
+	tA.Balance = convertedBalance
+	tA.Created = ff(ctx, fA.Created)
+	if fA.Owner != nil {
+		if tA.Owner == nil {
+			tA.Owner = new(domain.User)
+		}
+		UserToDomain(ctx, tA.Owner, fA.Owner)
+	}
+	return nil
+}
+
+// UserToDomain copies a *models.User to a *domain.User.
+func UserToDomain(ctx context.Context, tU *domain.User, fU *models.User) {
+	// *domain.User fields
+	tU.ID = fU.ID
+	tU.Name = Localize(ctx, fU.Name)
+}
+
+// AccountToSummary copies a *models.Account to a *domain.Summary.
+func AccountToSummary(tS *domain.Summary, fA *models.Account) {
+	// *domain.Summary fields
+	tS.ID = fA.ID
+	tS.Balance = fA.Balance
+}
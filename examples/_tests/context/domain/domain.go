@@ -0,0 +1,22 @@
+// Package domain contains business logic models.
+package domain
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	Balance int
+	Created string
+	Owner   *User
+}
+
+// Summary represents the summary of an account.
+type Summary struct {
+	ID      int
+	Balance int
+}
+
+// User represents a user.
+type User struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,18 @@
+// Package models contains data storage models (i.e database).
+package models
+
+import "time"
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	Balance int
+	Created time.Time
+	Owner   *User
+}
+
+// User represents a user.
+type User struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,39 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"context"
+	"time"
+
+	"github.com/switchupcb/copygen/examples/_tests/context/domain"
+	"github.com/switchupcb/copygen/examples/_tests/context/models"
+)
+
+// convert .*ToDomain models.User.Name
+// Localize localizes a name using the locale of a context.
+func Localize(ctx context.Context, name string) string {
+	if locale, ok := ctx.Value("locale").(string); ok {
+		return name + " (" + locale + ")"
+	}
+
+	return name
+}
+
+// convert AccountToDomain models.Account.Balance
+// Exchange exchanges a balance (until the context is canceled).
+func Exchange(ctx context.Context, balance int) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	AccountToDomain(context.Context, *models.Account, func(context.Context, time.Time) string) (*domain.Account, error)
+	UserToDomain(context.Context, *models.User) *domain.User
+
+	// AccountToSummary doesn't accept a context, so Exchange isn't applied to it.
+	AccountToSummary(*models.Account) *domain.Summary
+}
@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/switchupcb/copygen/cli"
@@ -17,6 +21,7 @@ type test struct {
 	name     string
 	ymlpath  string // ymlpath represents the path to an example's .yml file.
 	wantpath string // wantpath represents the path to a verified example's output file.
+	skiptmpl bool   // skiptmpl represents whether the example is skipped for the .tmpl method.
 }
 
 var (
@@ -36,49 +41,59 @@ var (
 			ymlpath:  "basic/setup/setup.yml",
 			wantpath: "basic/copygen.go",
 		},
-		/*
-			{
-				name:     "cast-assert",
-				ymlpath:  "cast/assert/setup.yml",
-				wantpath: "cast/assert/copygen.go",
-			},
-			{
-				name:     "cast-convert",
-				ymlpath:  "cast/convert/setup.yml",
-				wantpath: "cast/convert/copygen.go",
-			},
-			{
-				name:     "cast-depth",
-				ymlpath:  "cast/depth/setup.yml",
-				wantpath: "cast/depth/copygen.go",
-			},
-			{
-				name:     "cast-expression",
-				ymlpath:  "cast/expression/setup.yml",
-				wantpath: "cast/expression/copygen.go",
-			},
-			{
-				name:     "cast-function",
-				ymlpath:  "cast/function/setup.yml",
-				wantpath: "cast/function/copygen.go",
-			},
-			{
-				name:     "cast-property",
-				ymlpath:  "cast/property/setup.yml",
-				wantpath: "cast/property/copygen.go",
-			},
-		*/
-		/*
-			{
-				name:     "deepcopy",
-				ymlpath:  "deepcopy/setup/setup.yml",
-				wantpath: "deepcopy/copygen.go",
-			},
-		*/
+		{
+			name:     "cast-assert",
+			ymlpath:  "cast/assert/setup.yml",
+			wantpath: "cast/assert/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "cast-convert",
+			ymlpath:  "cast/convert/setup.yml",
+			wantpath: "cast/convert/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "cast-depth",
+			ymlpath:  "cast/depth/setup.yml",
+			wantpath: "cast/depth/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "cast-expression",
+			ymlpath:  "cast/expression/setup.yml",
+			wantpath: "cast/expression/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "cast-function",
+			ymlpath:  "cast/function/setup.yml",
+			wantpath: "cast/function/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "cast-property",
+			ymlpath:  "cast/property/setup.yml",
+			wantpath: "cast/property/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "deepcopy",
+			ymlpath:  "deepcopy/setup/setup.yml",
+			wantpath: "deepcopy/copygen.go",
+			skiptmpl: true,
+		},
 		{
 			name:     "error",
 			ymlpath:  "error/setup/setup.yml",
 			wantpath: "error/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "generic",
+			ymlpath:  "generic/setup/setup.yml",
+			wantpath: "generic/copygen.go",
+			skiptmpl: true,
 		},
 		{
 			name:     "map",
@@ -95,11 +110,35 @@ var (
 			ymlpath:  "_tests/alias/setup/setup.yml",
 			wantpath: "_tests/alias/copygen.go",
 		},
+		{
+			name:     "allocation",
+			ymlpath:  "_tests/allocation/setup/setup.yml",
+			wantpath: "_tests/allocation/copygen.go",
+			skiptmpl: true,
+		},
 		{
 			name:     "automap",
 			ymlpath:  "_tests/automap/setup/setup.yml",
 			wantpath: "_tests/automap/copygen.go",
 		},
+		{
+			name:     "collection",
+			ymlpath:  "_tests/collection/setup/setup.yml",
+			wantpath: "_tests/collection/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "constructor",
+			ymlpath:  "_tests/constructor/setup/setup.yml",
+			wantpath: "_tests/constructor/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "context",
+			ymlpath:  "_tests/context/setup/setup.yml",
+			wantpath: "_tests/context/copygen.go",
+			skiptmpl: true,
+		},
 		{
 			name:     "cyclic",
 			ymlpath:  "_tests/cyclic/setup/setup.yml",
@@ -110,6 +149,23 @@ var (
 			ymlpath:  "_tests/duplicate/setup/setup.yml",
 			wantpath: "_tests/duplicate/copygen.go",
 		},
+		{
+			name:     "fallible",
+			ymlpath:  "_tests/fallible/setup/setup.yml",
+			wantpath: "_tests/fallible/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "flatten",
+			ymlpath:  "_tests/flatten/setup/setup.yml",
+			wantpath: "_tests/flatten/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "generic",
+			ymlpath:  "_tests/generic/setup/setup.yml",
+			wantpath: "_tests/generic/copygen.go",
+		},
 		{
 			name:     "import",
 			ymlpath:  "_tests/import/setup/setup.yml",
@@ -120,6 +176,29 @@ var (
 			ymlpath:  "_tests/multi/setup/setup.yml",
 			wantpath: "_tests/multi/copygen.go",
 		},
+		{
+			name:     "naming",
+			ymlpath:  "_tests/naming/setup/setup.yml",
+			wantpath: "_tests/naming/copygen.go",
+		},
+		{
+			name:     "nested",
+			ymlpath:  "_tests/nested/setup/setup.yml",
+			wantpath: "_tests/nested/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "nil",
+			ymlpath:  "_tests/nil/setup/setup.yml",
+			wantpath: "_tests/nil/copygen.go",
+			skiptmpl: true,
+		},
+		{
+			name:     "nilerror",
+			ymlpath:  "_tests/nilerror/setup/setup.yml",
+			wantpath: "_tests/nilerror/copygen.go",
+			skiptmpl: true,
+		},
 		{
 			name:     "same",
 			ymlpath:  "_tests/same/setup/setup.yml",
@@ -137,6 +216,188 @@ func TestExamples(t *testing.T) {
 	}
 }
 
+// TestExamplesConcurrent tests calls to cli.Run() in parallel goroutines,
+// checking for a valid (deterministic) output.
+func TestExamplesConcurrent(t *testing.T) {
+	checkwd(t)
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(tests))
+	for i := range tests {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = runExample(tests[i])
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Run(%q) (concurrent) error: %v", tests[i].name, err)
+		}
+	}
+}
+
+// TestExamplesPattern tests a call to cli.RunPattern() using every example,
+// checking for a valid output.
+func TestExamplesPattern(t *testing.T) {
+	checkwd(t)
+
+	env := cli.Environment{
+		Pattern: "./...",
+		Output:  false,
+		Write:   false,
+	}
+
+	ymlpaths, err := config.FindYML(env.Pattern)
+	if err != nil {
+		t.Fatalf("FindYML(%q) error: %v", env.Pattern, err)
+	}
+
+	codes, err := env.RunPattern()
+	if err != nil {
+		t.Fatalf("RunPattern(%q) error: %v", env.Pattern, err)
+	}
+
+	if len(codes) != len(ymlpaths) {
+		t.Fatalf("RunPattern(%q) generated %d outputs for %d .yml files", env.Pattern, len(codes), len(ymlpaths))
+	}
+
+	for i, ymlpath := range ymlpaths {
+		for _, test := range tests {
+			if filepath.ToSlash(ymlpath) != test.ymlpath {
+				continue
+			}
+
+			valid, err := ioutil.ReadFile(test.wantpath)
+			if err != nil {
+				t.Fatalf("error reading file in test %q.\n%v", test.name, err)
+			}
+
+			if !bytes.Equal(normalizeLineBreaks([]byte(codes[i])), normalizeLineBreaks(valid)) {
+				t.Fatalf("RunPattern(%v) output not equivalent to %v", test.name, test.wantpath)
+			}
+		}
+	}
+}
+
+// TestExamplesCheck tests calls to cli.Run() using the check mode,
+// checking that every example's output file is up to date.
+func TestExamplesCheck(t *testing.T) {
+	checkwd(t)
+	for _, test := range tests {
+		env := cli.Environment{
+			YMLPath: test.ymlpath,
+			Check:   true,
+		}
+
+		if _, err := env.Run(); err != nil {
+			t.Fatalf("Run(%q) (check) error: %v", test.name, err)
+		}
+	}
+}
+
+// TestExamplesCheckStale tests a call to cli.Run() using the check mode and an out of date output file,
+// checking that a diff is reported without writing the output file.
+func TestExamplesCheckStale(t *testing.T) {
+	checkwd(t)
+
+	ymlpath := copyExample(t, "basic")
+	outpath := filepath.Join(filepath.Dir(filepath.Dir(ymlpath)), "copygen.go")
+
+	valid, err := ioutil.ReadFile("basic/copygen.go")
+	if err != nil {
+		t.Fatalf("error reading file in test %q.\n%v", "basic", err)
+	}
+
+	stale := bytes.Replace(normalizeLineBreaks(valid), []byte("// Basic copies"), []byte("// Basic copied"), 1)
+	if err := os.WriteFile(outpath, stale, 0o600); err != nil {
+		t.Fatalf("error writing file in test %q.\n%v", "basic", err)
+	}
+
+	env := cli.Environment{
+		YMLPath: ymlpath,
+		Check:   true,
+	}
+
+	_, err = env.Run()
+	if err == nil {
+		t.Fatalf("Run(%q) (check) expected an error for an out of date output file.", "basic")
+	}
+
+	for _, want := range []string{"@@ -", "-// Basic copied", "+// Basic copies"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("Run(%q) (check) got error %q, want error containing %q", "basic", err, want)
+		}
+	}
+
+	existing, err := ioutil.ReadFile(outpath)
+	if err != nil {
+		t.Fatalf("error reading file in test %q.\n%v", "basic", err)
+	}
+
+	if !bytes.Equal(existing, stale) {
+		t.Fatalf("Run(%q) (check) wrote the output file", "basic")
+	}
+}
+
+// TestExamplesYML tests a call to cli.Run() using a configuration without a .yml file,
+// checking for a valid output.
+func TestExamplesYML(t *testing.T) {
+	checkwd(t)
+
+	valid, err := ioutil.ReadFile("main/copygen.go")
+	if err != nil {
+		t.Fatalf("error reading file in test %q.\n%v", "main", err)
+	}
+
+	env := cli.Environment{
+		YML: &config.YML{
+			Generated: config.Generated{
+				Setup:  "setup.go",
+				Output: "../copygen.go",
+			},
+		},
+		Dir: "main/setup",
+	}
+
+	code, err := env.Run()
+	if err != nil {
+		t.Fatalf("Run(%q) error: %v", "main", err)
+	}
+
+	if !bytes.Equal(normalizeLineBreaks([]byte(code)), normalizeLineBreaks(valid)) {
+		t.Fatalf("Run(%v) output not equivalent to %v", "main", "main/copygen.go")
+	}
+}
+
+// runExample runs an example using the CLI Run() method and compares its output.
+func runExample(test test) error {
+	valid, err := ioutil.ReadFile(test.wantpath)
+	if err != nil {
+		return fmt.Errorf("error reading file.\n%w", err)
+	}
+
+	env := cli.Environment{
+		YMLPath: test.ymlpath,
+		Output:  false,
+		Write:   false,
+	}
+
+	code, err := env.Run()
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if !bytes.Equal(normalizeLineBreaks([]byte(code)), normalizeLineBreaks(valid)) {
+		return fmt.Errorf("output not equivalent to %v", test.wantpath)
+	}
+
+	return nil
+}
+
 // testExample tests an example using .go, .tmpl, and programmatic methods.
 func testExample(t *testing.T, test test) {
 	valid, err := ioutil.ReadFile(test.wantpath)
@@ -163,8 +424,8 @@ func testExample(t *testing.T, test test) {
 
 	fmt.Println("PASSED:", test.name)
 
-	// skip the custom generator error example for the .tmpl method.
-	if test.name == "error" {
+	// skip examples that use features (or custom generators) unsupported by the .tmpl method.
+	if test.skiptmpl {
 		return
 	}
 
@@ -182,6 +443,45 @@ func testExample(t *testing.T, test test) {
 	fmt.Println("PASSED:", test.name, "(tmpl)")
 }
 
+// TestExamplesInterpretedTemplate tests the default template (as a .go template) using every example
+// without a custom template, checking that the interpreter provides the symbols that the template uses.
+func TestExamplesInterpretedTemplate(t *testing.T) {
+	checkwd(t)
+	for _, test := range tests {
+		gen, err := config.LoadYML(test.ymlpath)
+		if err != nil {
+			t.Fatalf("Run(%q [interpreted]) error: %v", test.name, err)
+		}
+
+		if gen.Tempath != "" {
+			continue
+		}
+
+		if err = parser.Parse(gen); err != nil {
+			t.Fatalf("Run(%q [interpreted]) error: %v", test.name, err)
+		}
+
+		if err = matcher.Match(gen); err != nil {
+			t.Fatalf("Run(%q [interpreted]) error: %v", test.name, err)
+		}
+
+		gen.Tempath = "../cli/generator/template/generate.go"
+		code, err := generator.Generate(gen, false, false)
+		if err != nil {
+			t.Fatalf("Run(%q [interpreted]) error: %v", test.name, err)
+		}
+
+		valid, err := ioutil.ReadFile(test.wantpath)
+		if err != nil {
+			t.Fatalf("error reading file in test %q.\n%v", test.name, err)
+		}
+
+		if !bytes.Equal(normalizeLineBreaks([]byte(code)), normalizeLineBreaks(valid)) {
+			t.Fatalf("Run(%v [interpreted]) output not equivalent to %v", test.name, test.wantpath)
+		}
+	}
+}
+
 // templateRun runs copygen programmatically and generates code using a template.
 func templateRun(env cli.Environment) (string, error) {
 	gen, err := config.LoadYML(env.YMLPath)
@@ -0,0 +1,14 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/main/domain"
+	"github.com/switchupcb/copygen/examples/main/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	// ignore domain.Account.(UserID|Other)
+	Ignored(*models.Account, *models.User) *domain.Account
+	Unmatched(*models.Account, *models.User) *domain.Account
+}
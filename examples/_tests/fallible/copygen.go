@@ -0,0 +1,81 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/switchupcb/copygen/examples/_tests/fallible/domain"
+	"github.com/switchupcb/copygen/examples/_tests/fallible/models"
+)
+
+// Atoi converts an ascii value to an integer.
+func Atoi(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+// AccountToDomain copies a *models.Account, func(string) (time.Time, error) to a *domain.Account.
+func AccountToDomain(tA *domain.Account, fA *models.Account, ff func(string) (time.Time, error)) error {
+	// *domain.Account fields
+	tA.ID = fA.ID
+	convertedBalance, err := Atoi(fA.Balance)
+	if err != nil {
+		return fmt.Errorf("models.Account.Balance: %w", err)
+	}
+	tA.Balance = convertedBalance
+	convertedCreated, err := ff(fA.Created)
+	if err != nil {
+		return fmt.Errorf("models.Account.Created: %w", err)
+	}
+	tA.Created = convertedCreated
+	if fA.Owner != nil {
+		if tA.Owner == nil {
+			tA.Owner = new(domain.User)
+		}
+		if err := UserToDomain(tA.Owner, fA.Owner); err != nil {
+			return fmt.Errorf("models.Account.Owner: %w", err)
+		}
+	}
+	if fA.Users != nil {
+		tA.Users = make([]*domain.User, len(fA.Users))
+		for i := range fA.Users {
+			tA.Users[i] = new(domain.User)
+			if err := UserToDomain(tA.Users[i], &fA.Users[i]); err != nil {
+				return fmt.Errorf("models.Account.Users[%d]: %w", i, err)
+			}
+		}
+	}
+	if fA.Renewed != nil {
+		tA.Renewed = make(map[string]time.Time, len(fA.Renewed))
+		for k, v := range fA.Renewed {
+			convertedRenewed, err := ff(v)
+			if err != nil {
+				return fmt.Errorf("models.Account.Renewed[%v]: %w", k, err)
+			}
+			tA.Renewed[k] = convertedRenewed
+		}
+	}
+	return nil
+}
+
+// UserToDomain copies a *models.User to a *domain.User.
+func UserToDomain(tU *domain.User, fU *models.User) error {
+	// *domain.User fields
+	tU.ID = fU.ID
+	convertedAge, err := Atoi(fU.Age)
+	if err != nil {
+		return fmt.Errorf("models.User.Age: %w", err)
+	}
+	tU.Age = convertedAge
+	return nil
+}
+
+// AccountToSummary copies a *models.Account to a *domain.Summary.
+func AccountToSummary(tS *domain.Summary, fA *models.Account) {
+	// *domain.Summary fields
+	tS.ID = fA.ID
+}
@@ -0,0 +1,26 @@
+// Package domain contains business logic models.
+package domain
+
+import "time"
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	Balance int
+	Created time.Time
+	Owner   *User
+	Users   []*User
+	Renewed map[string]time.Time
+}
+
+// Summary represents the summary of an account.
+type Summary struct {
+	ID      int
+	Balance int
+}
+
+// User represents a user.
+type User struct {
+	ID  int
+	Age int
+}
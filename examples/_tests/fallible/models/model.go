@@ -0,0 +1,18 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	Balance string
+	Created string
+	Owner   *User
+	Users   []User
+	Renewed map[string]string
+}
+
+// User represents a user.
+type User struct {
+	ID  int
+	Age string
+}
@@ -0,0 +1,26 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/switchupcb/copygen/examples/_tests/fallible/domain"
+	"github.com/switchupcb/copygen/examples/_tests/fallible/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	AccountToDomain(*models.Account, func(string) (time.Time, error)) (*domain.Account, error)
+	UserToDomain(*models.User) (*domain.User, error)
+
+	// AccountToSummary doesn't return an error, so Atoi isn't applied to it.
+	AccountToSummary(*models.Account) *domain.Summary
+}
+
+// convert .*ToDomain models.Account.Balance
+// convert .*ToDomain models.User.Age
+// Atoi converts an ascii value to an integer.
+func Atoi(s string) (int, error) {
+	return strconv.Atoi(s)
+}
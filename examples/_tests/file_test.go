@@ -30,3 +30,35 @@ func normalizeLineBreaks(d []byte) []byte {
 	d = bytes.Replace(d, []byte{13}, []byte{10}, -1)
 	return d
 }
+
+// copyExample copies the setup directory of an example to a temporary directory,
+// then returns the path to the copied .yml file.
+//
+// The copy is created in the examples module, such that its imports are resolved.
+func copyExample(t *testing.T, example string) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("_tests", "copy")
+	if err != nil {
+		t.Fatalf("error creating a temporary directory.\n%v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	setupdir := filepath.Join(dir, "setup")
+	if err := os.Mkdir(setupdir, 0o755); err != nil {
+		t.Fatalf("error creating a temporary directory.\n%v", err)
+	}
+
+	for _, file := range []string{"setup.yml", "setup.go"} {
+		data, err := os.ReadFile(filepath.Join(example, "setup", file))
+		if err != nil {
+			t.Fatalf("error reading file %q.\n%v", file, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(setupdir, file), data, 0o600); err != nil {
+			t.Fatalf("error writing file %q.\n%v", file, err)
+		}
+	}
+
+	return filepath.Join(setupdir, "setup.yml")
+}
@@ -0,0 +1,33 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/flatten/domain"
+	"github.com/switchupcb/copygen/examples/_tests/flatten/models"
+)
+
+// Unflatten copies a *models.UserDTO to a *domain.User.
+func Unflatten(tU *domain.User, fU *models.UserDTO) {
+	// *domain.User fields
+	tU.ID = fU.ID
+	if tU.Address == nil {
+		tU.Address = new(domain.Address)
+	}
+	tU.Address.City = fU.AddressCity
+	tU.Address.Zip = fU.AddressZip
+	tU.Contact.Mail = fU.ContactMail
+}
+
+// Flatten copies a *domain.User to a *models.UserDTO.
+func Flatten(tU *models.UserDTO, fU *domain.User) {
+	// *models.UserDTO fields
+	tU.ID = fU.ID
+	if fU.Address != nil {
+		tU.AddressCity = fU.Address.City
+		tU.AddressZip = fU.Address.Zip
+	}
+	tU.ContactMail = fU.Contact.Mail
+}
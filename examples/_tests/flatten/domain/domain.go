@@ -0,0 +1,20 @@
+// Package domain contains business logic models.
+package domain
+
+// User represents a user of the domain.
+type User struct {
+	ID      int
+	Address *Address
+	Contact Contact
+}
+
+// Address represents the address of a user.
+type Address struct {
+	City string
+	Zip  string
+}
+
+// Contact represents the contact information of a user.
+type Contact struct {
+	Mail string
+}
@@ -0,0 +1,10 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// UserDTO represents the flat data transfer object for a user.
+type UserDTO struct {
+	ID          int
+	AddressCity string
+	AddressZip  string
+	ContactMail string
+}
@@ -0,0 +1,13 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/flatten/domain"
+	"github.com/switchupcb/copygen/examples/_tests/flatten/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	Unflatten(*models.UserDTO) *domain.User
+	Flatten(*domain.User) *models.UserDTO
+}
@@ -0,0 +1,48 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/generic/domain"
+	"github.com/switchupcb/copygen/examples/_tests/generic/models"
+)
+
+// Wrapper represents a type that is defined in the setup file's package.
+type Wrapper[T any] struct {
+	Value T
+}
+
+// ModelsToDomainPage copies a *models.Page[models.User] to a *domain.Page[models.User].
+func ModelsToDomainPage(tP *domain.Page[models.User], fP *models.Page[models.User]) {
+	// *domain.Page[models.User] fields
+	tP.Items = fP.Items
+	tP.Total = fP.Total
+}
+
+// ModelsToDomainResult copies a models.Result[*models.User], models.Optional[string] to a domain.Result[*models.User].
+func ModelsToDomainResult(tR domain.Result[*models.User], fR models.Result[*models.User], fO models.Optional[string]) {
+	// domain.Result[*models.User] fields
+	tR.Value = fR.Value
+	tR.Err = fR.Err
+}
+
+// ModelsToDomainOptional copies a *models.Optional[[]models.User] to a *domain.Optional[[]models.User].
+func ModelsToDomainOptional(tO *domain.Optional[[]models.User], fO *models.Optional[[]models.User]) {
+	// *domain.Optional[[]models.User] fields
+	tO.Value = fO.Value
+	tO.Valid = fO.Valid
+}
+
+// ModelsToDomainPair copies a models.Optional[int] to a domain.Pair[string, int].
+func ModelsToDomainPair(tP domain.Pair[string, int], fO models.Optional[int]) {
+	// domain.Pair[string, int] fields
+	tP.Value = fO.Value
+}
+
+// ModelsToWrapper copies a models.Optional[models.User] to a Wrapper[models.User].
+func ModelsToWrapper(tW Wrapper[models.User], fO models.Optional[models.User]) {
+	// Wrapper[models.User] fields
+	tW.Value = fO.Value
+}
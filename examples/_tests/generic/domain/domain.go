@@ -0,0 +1,26 @@
+// Package domain contains business logic models.
+package domain
+
+// Page represents a page of items.
+type Page[T any] struct {
+	Items []T
+	Total int
+}
+
+// Result represents the result of an operation.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Optional represents an optional value.
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// Pair represents a pair of values.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
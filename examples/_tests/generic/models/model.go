@@ -0,0 +1,27 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Page represents the data model for a page of items.
+type Page[T any] struct {
+	Items []T
+	Total int
+	Next  *string
+}
+
+// Result represents the data model for the result of an operation.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Optional represents the data model for an optional value.
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// User represents the data model for a user.
+type User struct {
+	ID   int
+	Name string
+}
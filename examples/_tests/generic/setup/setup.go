@@ -0,0 +1,23 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/generic/domain"
+	"github.com/switchupcb/copygen/examples/_tests/generic/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	ModelsToDomainPage(*models.Page[models.User]) *domain.Page[models.User]
+	ModelsToDomainResult(models.Result[*models.User], models.Optional[string]) domain.Result[*models.User]
+	ModelsToDomainOptional(*models.Optional[[]models.User]) *domain.Optional[[]models.User]
+
+	// map models.Optional\[int\].Value domain.Pair[string,int].Value
+	ModelsToDomainPair(models.Optional[int]) domain.Pair[string, int]
+	ModelsToWrapper(models.Optional[models.User]) Wrapper[models.User]
+}
+
+// Wrapper represents a type that is defined in the setup file's package.
+type Wrapper[T any] struct {
+	Value T
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/switchupcb/copygen/cli/config"
+	"github.com/switchupcb/copygen/cli/matcher"
+	"github.com/switchupcb/copygen/cli/parser"
+)
+
+// TestMatchExhaustive tests whether the to-fields that are not matched (or ignored)
+// are reported in exhaustive mode.
+func TestMatchExhaustive(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/exhaustive/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Match(%q) error: %v", "exhaustive", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Match(%q) error: %v", "exhaustive", err)
+	}
+
+	err = matcher.Match(gen)
+	if err == nil {
+		t.Fatalf("Match(%q) expected an error for unmatched to-fields.", "exhaustive")
+	}
+
+	want := "the function Unmatched contains to-fields that are not matched (or ignored):\n\tdomain.Account.UserID\n\tdomain.Account.Other"
+	if err.Error() != want {
+		t.Fatalf("Match(%q) got error %q, want %q", "exhaustive", err, want)
+	}
+}
+
+// TestMatchCastModifierDepth tests whether a from-field with a cast modifier is casted
+// when automatic casting is disabled using a 0 cast depth.
+func TestMatchCastModifierDepth(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("cast/function/setup.yml")
+	if err != nil {
+		t.Fatalf("Match(%q) error: %v", "cast-function", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Match(%q) error: %v", "cast-function", err)
+	}
+
+	gen.Options.Matcher.CastDepth = 0
+	if err = matcher.Match(gen); err != nil {
+		t.Fatalf("Match(%q) error: %v", "cast-function", err)
+	}
+
+	for _, function := range gen.Functions {
+		if toField := function.To[0].Field; toField.From == nil {
+			t.Fatalf("Match(%q) got an unmatched to-field %v in function %v, want a casted to-field", "cast-function", toField.FullName(), function.Name)
+		}
+	}
+}
+
+// TestMatchAmbiguous tests whether a from-field that's matched to multiple to-fields
+// using a naming strategy is reported.
+func TestMatchAmbiguous(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/naming/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Match(%q) error: %v", "naming", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Match(%q) error: %v", "naming", err)
+	}
+
+	stdout := captureStdout(t, func() {
+		err = matcher.Match(gen)
+	})
+
+	if err != nil {
+		t.Fatalf("Match(%q) error: %v", "naming", err)
+	}
+
+	want := "WARNING: function RowToDomain: the from-field models.UserRow.Email matches multiple to-fields: domain.User.Email, domain.User.EmailAddress\n"
+	if stdout != want {
+		t.Fatalf("Match(%q) got output %q, want %q", "naming", stdout, want)
+	}
+}
+
+// captureStdout returns the output that's printed to stdout while a function is called.
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe() error: %v", err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	output := make(chan string)
+	go func() {
+		var buf strings.Builder
+		_, _ = io.Copy(&buf, r)
+		output <- buf.String()
+	}()
+
+	f()
+	w.Close()
+
+	return <-output
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/switchupcb/copygen/cli/models"
+)
+
+// TestDeepcopyOptions tests whether a deepcopied field copies every option of the field.
+func TestDeepcopyOptions(t *testing.T) {
+	depth := 2
+	field := &models.Field{
+		Name:       "ID",
+		Definition: "int",
+		Options: models.FieldOptions{
+			Cast:           ".String()",
+			CastTo:         "domain.Account.ID",
+			CastDepth:      &depth,
+			Convert:        "Itoa",
+			ConvertError:   true,
+			ConvertContext: true,
+			Map:            "domain.Account.ID",
+			Tag:            "json",
+			Depth:          1,
+			Automatch:      true,
+			AutoCast:       true,
+			Deepcopy:       true,
+			Ignore:         true,
+		},
+	}
+
+	// every option is set, such that an option that isn't copied is detected.
+	options := reflect.ValueOf(field.Options)
+	for i := 0; i < options.NumField(); i++ {
+		if options.Field(i).IsZero() {
+			t.Fatalf("Deepcopy() test field doesn't set the option %v", options.Type().Field(i).Name)
+		}
+	}
+
+	copied := field.Deepcopy(nil)
+	if !reflect.DeepEqual(copied.Options, field.Options) {
+		t.Fatalf("Deepcopy() got options %+v, want %+v", copied.Options, field.Options)
+	}
+
+	if copied.Options.CastDepth == field.Options.CastDepth {
+		t.Fatalf("Deepcopy() got a cast depth that references the field's cast depth")
+	}
+}
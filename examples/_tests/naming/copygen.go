@@ -0,0 +1,28 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/naming/domain"
+	"github.com/switchupcb/copygen/examples/_tests/naming/models"
+)
+
+// DtoToDomain copies a *models.UserDto to a *domain.User.
+func DtoToDomain(tU *domain.User, fU *models.UserDto) {
+	// *domain.User fields
+	tU.UserID = fU.UserId
+	tU.Email = fU.Mail
+	tU.Name = fU.DbName
+	tU.Age = fU.AgePb
+}
+
+// RowToDomain copies a *models.UserRow to a *domain.User.
+func RowToDomain(tU *domain.User, fU *models.UserRow) {
+	// *domain.User fields
+	tU.UserID = fU.ID
+	tU.Email = fU.Email
+	tU.EmailAddress = fU.Email
+	tU.Name = fU.Name
+}
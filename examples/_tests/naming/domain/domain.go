@@ -0,0 +1,11 @@
+// Package domain contains business logic models.
+package domain
+
+// User represents a user of the domain.
+type User struct {
+	UserID       int
+	Email        string
+	EmailAddress string
+	Name         string
+	Age          int
+}
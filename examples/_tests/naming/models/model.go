@@ -0,0 +1,18 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// UserDto represents the data transfer object for a user.
+type UserDto struct {
+	UserId   int
+	Mail     string `json:"email"`
+	DbName   string
+	AgePb    int
+	Password string
+}
+
+// UserRow represents the database row for a user.
+type UserRow struct {
+	ID    int    `db:"user_id"`
+	Email string `db:"email_address"`
+	Name  string `db:"-"`
+}
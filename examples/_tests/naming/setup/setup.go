@@ -0,0 +1,15 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/naming/domain"
+	"github.com/switchupcb/copygen/examples/_tests/naming/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	DtoToDomain(*models.UserDto) *domain.User
+
+	// naming snake tag:db
+	RowToDomain(*models.UserRow) *domain.User
+}
@@ -0,0 +1,30 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/nested/domain"
+	"github.com/switchupcb/copygen/examples/_tests/nested/models"
+)
+
+// AccountToDomain copies a *models.Account to a *domain.Account.
+func AccountToDomain(tA *domain.Account, fA *models.Account) {
+	// *domain.Account fields
+	tA.ID = fA.ID
+	if fA.User != nil {
+		UserToDomain(&tA.User, fA.User)
+	}
+	if tA.Manager == nil {
+		tA.Manager = new(domain.User)
+	}
+	UserToDomain(tA.Manager, &fA.Manager)
+}
+
+// UserToDomain copies a *models.User to a *domain.User.
+func UserToDomain(tU *domain.User, fU *models.User) {
+	// *domain.User fields
+	tU.ID = fU.ID
+	tU.Name = fU.Username
+}
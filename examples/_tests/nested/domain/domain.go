@@ -0,0 +1,15 @@
+// Package domain contains business logic models.
+package domain
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	User    User
+	Manager *User
+}
+
+// User represents a user.
+type User struct {
+	ID   int
+	Name string
+}
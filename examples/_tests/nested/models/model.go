@@ -0,0 +1,15 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	User    *User
+	Manager User
+}
+
+// User represents a user.
+type User struct {
+	ID       int
+	Username string
+}
@@ -0,0 +1,16 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/nested/domain"
+	"github.com/switchupcb/copygen/examples/_tests/nested/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	AccountToDomain(*models.Account) *domain.Account
+
+	// map models.User.ID domain.User.ID
+	// map models.User.Username domain.User.Name
+	UserToDomain(*models.User) *domain.User
+}
@@ -0,0 +1,79 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"errors"
+
+	"github.com/switchupcb/copygen/examples/_tests/nil/domain"
+	"github.com/switchupcb/copygen/examples/_tests/nil/models"
+)
+
+// SkipToDomain copies a *models.Account to a *domain.Account.
+func SkipToDomain(tA *domain.Account, fA *models.Account) {
+	// *domain.Account fields
+	if fA.ID != nil {
+		tA.ID = *fA.ID
+	}
+	if fA.Name != nil {
+		tA.Name = *fA.Name
+	}
+	if fA.User != nil {
+		tA.Email = fA.User.Email
+		if tA.Contact == nil {
+			tA.Contact = new(domain.Contact)
+		}
+		tA.Contact.Phone = fA.User.Phone
+	}
+}
+
+// ZeroToDomain copies a *models.Account to a *domain.Account.
+func ZeroToDomain(tA *domain.Account, fA *models.Account) {
+	// *domain.Account fields
+	if fA.ID != nil {
+		tA.ID = *fA.ID
+	} else {
+		tA.ID = 0
+	}
+	if fA.Name != nil {
+		tA.Name = *fA.Name
+	} else {
+		tA.Name = ""
+	}
+	if fA.User != nil {
+		tA.Email = fA.User.Email
+		if tA.Contact == nil {
+			tA.Contact = new(domain.Contact)
+		}
+		tA.Contact.Phone = fA.User.Phone
+	} else {
+		tA.Email = ""
+		if tA.Contact != nil {
+			tA.Contact.Phone = ""
+		}
+	}
+}
+
+// ErrorToDomain copies a *models.Account to a *domain.Account.
+func ErrorToDomain(tA *domain.Account, fA *models.Account) error {
+	// *domain.Account fields
+	if fA.ID == nil {
+		return errors.New("models.Account.ID is nil")
+	}
+	tA.ID = *fA.ID
+	if fA.Name == nil {
+		return errors.New("models.Account.Name is nil")
+	}
+	tA.Name = *fA.Name
+	if fA.User == nil {
+		return errors.New("models.Account.User is nil")
+	}
+	tA.Email = fA.User.Email
+	if tA.Contact == nil {
+		tA.Contact = new(domain.Contact)
+	}
+	tA.Contact.Phone = fA.User.Phone
+	return nil
+}
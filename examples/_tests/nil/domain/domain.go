@@ -0,0 +1,15 @@
+// Package domain contains business logic models.
+package domain
+
+// Account represents a user's account.
+type Account struct {
+	ID      int
+	Name    string
+	Email   string
+	Contact *Contact
+}
+
+// Contact represents a user's contact information.
+type Contact struct {
+	Phone string
+}
@@ -0,0 +1,18 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/nil/domain"
+	"github.com/switchupcb/copygen/examples/_tests/nil/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	SkipToDomain(*models.Account) *domain.Account
+
+	// nil zero
+	ZeroToDomain(*models.Account) *domain.Account
+
+	// nil error
+	ErrorToDomain(*models.Account) *domain.Account
+}
@@ -0,0 +1,42 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/switchupcb/copygen/examples/_tests/nilerror/domain"
+	"github.com/switchupcb/copygen/examples/_tests/nilerror/models"
+)
+
+// TeamToDomain copies a *models.Team to a *domain.Team.
+func TeamToDomain(tT *domain.Team, fT *models.Team) error {
+	// *domain.Team fields
+	if fT.Owner == nil {
+		return errors.New("models.Team.Owner is nil")
+	}
+	if tT.Owner == nil {
+		tT.Owner = new(domain.User)
+	}
+	UserToDomain(tT.Owner, fT.Owner)
+	if fT.Members == nil {
+		return errors.New("models.Team.Members is nil")
+	}
+	tT.Members = make([]domain.User, len(fT.Members))
+	for i := range fT.Members {
+		if fT.Members[i] == nil {
+			return fmt.Errorf("models.Team.Members[%d] is nil", i)
+		}
+		UserToDomain(&tT.Members[i], fT.Members[i])
+	}
+	return nil
+}
+
+// UserToDomain copies a *models.User to a *domain.User.
+func UserToDomain(tU *domain.User, fU *models.User) {
+	// *domain.User fields
+	tU.Name = fU.Name
+}
@@ -0,0 +1,13 @@
+// Package domain contains business logic models.
+package domain
+
+// Team represents a team of users.
+type Team struct {
+	Owner   *User
+	Members []User
+}
+
+// User represents a user.
+type User struct {
+	Name string
+}
@@ -0,0 +1,13 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Team represents a team of users.
+type Team struct {
+	Owner   *User
+	Members []*User
+}
+
+// User represents a user.
+type User struct {
+	Name string
+}
@@ -0,0 +1,15 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/nilerror/domain"
+	"github.com/switchupcb/copygen/examples/_tests/nilerror/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	// nil error
+	TeamToDomain(*models.Team) *domain.Team
+
+	UserToDomain(*models.User) *domain.User
+}
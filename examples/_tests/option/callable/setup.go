@@ -0,0 +1,27 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	gocontext "context"
+	c "strconv"
+
+	"github.com/switchupcb/copygen/examples/main/domain"
+	"github.com/switchupcb/copygen/examples/main/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	ModelsToDomain(gocontext.Context, *models.Account, *models.User, func(gocontext.Context, string) string) (*domain.Account, error)
+	ModelsToDomainUncallable(*models.Account, *models.User) *domain.Account
+}
+
+/* Define the function and field this converter is applied to using regex. */
+// convert .* models.User.UserID
+// Itoa converts an integer to an ascii value (until the context is canceled).
+func Itoa(ctx gocontext.Context, i int) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	return c.Itoa(i), nil
+}
@@ -0,0 +1,22 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	c "strconv"
+
+	"github.com/switchupcb/copygen/examples/main/domain"
+	"github.com/switchupcb/copygen/examples/main/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	ModelsToDomain(*models.Account, *models.User) *domain.Account
+	ModelsToDomainUnconverted(*models.Account, *models.User) *domain.Account
+}
+
+/* Define the function and field this converter is applied to using regex. */
+// convert ModelsToDomain models.User.UserID
+// Itoa converts an integer to an ascii value.
+func Itoa(i int) string {
+	return c.Itoa(i)
+}
@@ -0,0 +1,14 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/map/domain"
+	"github.com/switchupcb/copygen/examples/map/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	A(*models.Account) *domain.Account
+	// map .ID
+	B(*models.User) *domain.Account
+}
@@ -0,0 +1,27 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	c "strconv"
+
+	"github.com/switchupcb/copygen/examples/main/domain"
+	"github.com/switchupcb/copygen/examples/main/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	// map models.Account.ID domain.Account.ID
+	// map models.User.UserID domain.Account.AccountID
+	// deepcopy models.User.Password
+	// ignore true
+	// cast models.Account.ID domain.Account.AccountID 2
+	ModelsToDomain(*models.Account, *models.User) *domain.Account
+}
+
+/* Define the function and field this converter is applied to using regex. */
+// convert .* models.User.ID
+// convert DomainToModels models.User.UserID
+// Itoa converts an integer to an ascii value.
+func Itoa(i int) string {
+	return c.Itoa(i)
+}
@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/switchupcb/copygen/cli"
@@ -87,3 +89,343 @@ func TestCustomFunctionOptions(t *testing.T) {
 		}
 	}
 }
+
+// TestOptionErrorPosition tests whether option errors report their position in the setup file.
+func TestOptionErrorPosition(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/option/position/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Position", err)
+	}
+
+	err = parser.Parse(gen)
+	if err == nil {
+		t.Fatalf("Options(%q) expected an error for a misconfigured option.", "Position")
+	}
+
+	want := filepath.Join("_tests", "option", "position", "setup.go") + ":12:2: function B: "
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("Options(%q) got error %q, want error containing %q", "Position", err, want)
+	}
+}
+
+// TestUnusedOptions tests whether options that don't match a field are reported in strict mode.
+func TestUnusedOptions(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/option/unused/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Unused", err)
+	}
+
+	err = parser.Parse(gen)
+	if err == nil {
+		t.Fatalf("Options(%q) expected an error for unused options in strict mode.", "Unused")
+	}
+
+	wanted := []string{
+		"setup.go:14:2: function ModelsToDomain: the map option does not match a field: models.User.UserID domain.Account.AccountID",
+		"setup.go:15:2: function ModelsToDomain: the deepcopy option does not match a field: models.User.Password",
+		"setup.go:16:2: function ModelsToDomain: the ignore option does not match a field: true (ignore is a built-in option, so a custom ignore option must be renamed)",
+		"setup.go:17:2: function ModelsToDomain: the cast option does not match a field: models.Account.ID domain.Account.AccountID",
+		"setup.go:22:1: function Itoa: the convert option does not match a field: models.User.ID",
+		"setup.go:23:1: function Itoa: the convert option does not match a function: DomainToModels",
+	}
+
+	for _, want := range wanted {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("Options(%q) got error %q, want error containing %q", "Unused", err, want)
+		}
+	}
+
+	if strings.Contains(err.Error(), "domain.Account.ID") {
+		t.Fatalf("Options(%q) got error %q, which reports a matched option", "Unused", err)
+	}
+}
+
+// TestConvertScope tests whether convert options are only applied to the functions that their function regex matches.
+func TestConvertScope(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/option/convert/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Convert", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Options(%q) error: %v", "Convert", err)
+	}
+
+	wanted := []string{"Itoa", ""}
+	for i, function := range gen.Functions {
+		for _, field := range function.From[1].Field.AllFields(nil, nil) {
+			if field.Name == "UserID" && field.Options.Convert != wanted[i] {
+				t.Fatalf("Options(%q) got convert function %q for %v in function %v, want %q", "Convert", field.Options.Convert, field.FullName(), function.Name, wanted[i])
+			}
+		}
+	}
+}
+
+// TestUncallableConvert tests whether convert options are reported (in strict mode) instead of applied
+// to functions that can't call their convert function, which accepts an aliased context.
+func TestUncallableConvert(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/option/callable/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Callable", err)
+	}
+
+	err = parser.Parse(gen)
+	if err == nil {
+		t.Fatalf("Options(%q) expected an error for uncallable convert options in strict mode.", "Callable")
+	}
+
+	wanted := []string{
+		"setup.go:19:1: function Itoa: the convert option does not match a function that returns an error: ModelsToDomainUncallable",
+	}
+
+	for _, want := range wanted {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("Options(%q) got error %q, want error containing %q", "Callable", err, want)
+		}
+	}
+
+	if strings.Contains(err.Error(), "does not match a field") || strings.Count(err.Error(), "does not match") != len(wanted) {
+		t.Fatalf("Options(%q) got error %q, which reports a callable option", "Callable", err)
+	}
+
+	// the convert option is applied to the function that can call its convert function.
+	gen.Options.Parser.Strict = false
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Options(%q) error: %v", "Callable", err)
+	}
+
+	wantedConvert := []string{"Itoa", ""}
+	for i, function := range gen.Functions {
+		for _, field := range function.From[1].Field.AllFields(nil, nil) {
+			if field.Name != "UserID" {
+				continue
+			}
+
+			if field.Options.Convert != wantedConvert[i] || field.Options.ConvertContext != (wantedConvert[i] != "") {
+				t.Fatalf("Options(%q) got convert function %q (context %v) for %v in function %v, want %q", "Callable", field.Options.Convert, field.Options.ConvertContext, field.FullName(), function.Name, wantedConvert[i])
+			}
+		}
+	}
+
+	// a converter parameter that accepts an aliased context is a converter that accepts a context.
+	if converter := gen.Functions[0].From[2].Field; !converter.AcceptsContext() {
+		t.Fatalf("Options(%q) got converter %v, which doesn't accept a context", "Callable", converter.FullDefinition())
+	}
+
+	// an aliased context is referenced by its alias (in the same manner as other parameters).
+	if definition := gen.Functions[0].ContextDefinition; definition != "gocontext.Context" {
+		t.Fatalf("Options(%q) got context definition %q, want %q", "Callable", definition, "gocontext.Context")
+	}
+
+	if definition := gen.Functions[0].From[2].Field.FullDefinition(); definition != "func(gocontext.Context, string) string" {
+		t.Fatalf("Options(%q) got converter definition %q, want %q", "Callable", definition, "func(gocontext.Context, string) string")
+	}
+}
+
+// TestUnknownOptionCategory tests whether unknown option categories are reported
+// when custom option categories are declared.
+func TestUnknownOptionCategory(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/option/category/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Category", err)
+	}
+
+	err = parser.Parse(gen)
+	if err == nil {
+		t.Fatalf("Options(%q) expected an error for an unknown option category.", "Category")
+	}
+
+	want := "setup.go:12:2: function ModelsToDomain: there is an unknown option category: \"mpa\".\nDid you mean \"map\"?"
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("Options(%q) got error %q, want error containing %q", "Category", err, want)
+	}
+}
+
+// TestNilPolicy tests whether the nil policy of each function is determined
+// by its nil option (or the generator), and whether invalid nil policies are reported.
+func TestNilPolicy(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/nil/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Nil", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Options(%q) error: %v", "Nil", err)
+	}
+
+	wanted := []string{"skip", "zero", "error"}
+	for i, function := range gen.Functions {
+		if function.Options.Nil != wanted[i] {
+			t.Fatalf("Options(%q) got nil policy %q for function %v, want %q", "Nil", function.Options.Nil, function.Name, wanted[i])
+		}
+	}
+
+	yml := config.YML{
+		Generated: config.Generated{
+			Setup:  "setup.go",
+			Output: "../copygen.go",
+			Nil:    "panic",
+		},
+	}
+
+	if _, err := config.NewGenerator(yml, "_tests/nil/setup"); err == nil {
+		t.Fatalf("Options(%q) expected an error for an invalid nil policy.", "Nil")
+	}
+}
+
+// TestCastDepth tests whether an unset cast depth uses the default depth,
+// while a 0 cast depth disables automatic casting and a negative cast depth is reported.
+func TestCastDepth(t *testing.T) {
+	checkwd(t)
+
+	zero, negative := 0, -1
+	depths := []struct {
+		depth *int
+		want  int
+	}{
+		{depth: nil, want: 1},
+		{depth: &zero, want: 0},
+	}
+
+	for _, depth := range depths {
+		yml := config.YML{
+			Generated: config.Generated{
+				Setup:  "setup.go",
+				Output: "../copygen.go",
+			},
+			Matcher: config.Matcher{
+				Cast: config.Cast{Depth: depth.depth},
+			},
+		}
+
+		gen, err := config.NewGenerator(yml, "_tests/nil/setup")
+		if err != nil {
+			t.Fatalf("Options(%q) error: %v", "Cast", err)
+		}
+
+		if gen.Options.Matcher.CastDepth != depth.want {
+			t.Fatalf("Options(%q) got cast depth %d, want %d", "Cast", gen.Options.Matcher.CastDepth, depth.want)
+		}
+	}
+
+	yml := config.YML{
+		Generated: config.Generated{
+			Setup:  "setup.go",
+			Output: "../copygen.go",
+		},
+		Matcher: config.Matcher{
+			Cast: config.Cast{Depth: &negative},
+		},
+	}
+
+	if _, err := config.NewGenerator(yml, "_tests/nil/setup"); err == nil {
+		t.Fatalf("Options(%q) expected an error for a negative cast depth.", "Cast")
+	}
+}
+
+// TestConstructor tests whether each function is a constructor
+// according to its constructor option (or the generator).
+func TestConstructor(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/constructor/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Constructor", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Options(%q) error: %v", "Constructor", err)
+	}
+
+	wanted := []bool{true, true, true, false}
+	for i, function := range gen.Functions {
+		if function.Options.Constructor != wanted[i] {
+			t.Fatalf("Options(%q) got constructor %v for function %v, want %v", "Constructor", function.Options.Constructor, function.Name, wanted[i])
+		}
+	}
+}
+
+// TestErrorResult tests whether a function's trailing error result is parsed (instead of a to-type),
+// and whether a convert function that returns an error is parsed as one.
+func TestErrorResult(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/fallible/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Error", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Options(%q) error: %v", "Error", err)
+	}
+
+	wanted := []bool{true, true, false}
+	for i, function := range gen.Functions {
+		if function.ReturnsError() != wanted[i] || len(function.To) != 1 {
+			t.Fatalf("Options(%q) got error %v and %d to-types for function %v, want %v and 1", "Error", function.ReturnsError(), len(function.To), function.Name, wanted[i])
+		}
+	}
+
+	for _, field := range gen.Functions[0].From[0].Field.AllFields(nil, nil) {
+		if field.Name != "Balance" {
+			continue
+		}
+
+		if field.Options.Convert != "Atoi" || !field.Options.ConvertError {
+			t.Fatalf("Options(%q) got convert function %q (error %v) for %v, want %q (error true)", "Error", field.Options.Convert, field.Options.ConvertError, field.FullName(), "Atoi")
+		}
+
+		return
+	}
+
+	t.Fatalf("Options(%q) could not find the field %v", "Error", "models.Account.Balance")
+}
+
+// TestContextParameter tests whether a function's context parameter is parsed (instead of a from-type),
+// and whether a convert function that accepts a context is parsed as one.
+func TestContextParameter(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/context/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Options(%q) error: %v", "Context", err)
+	}
+
+	if err = parser.Parse(gen); err != nil {
+		t.Fatalf("Options(%q) error: %v", "Context", err)
+	}
+
+	wanted := []bool{true, true, false}
+	wantedFrom := []int{2, 1, 1}
+	for i, function := range gen.Functions {
+		if function.Context != wanted[i] || len(function.From) != wantedFrom[i] {
+			t.Fatalf("Options(%q) got context %v and %d from-types for function %v, want %v and %d", "Context", function.Context, len(function.From), function.Name, wanted[i], wantedFrom[i])
+		}
+	}
+
+	for _, field := range gen.Functions[1].From[0].Field.AllFields(nil, nil) {
+		if field.Name != "Name" {
+			continue
+		}
+
+		if field.Options.Convert != "Localize" || !field.Options.ConvertContext {
+			t.Fatalf("Options(%q) got convert function %q (context %v) for %v, want %q (context true)", "Context", field.Options.Convert, field.Options.ConvertContext, field.FullName(), "Localize")
+		}
+
+		return
+	}
+
+	t.Fatalf("Options(%q) could not find the field %v", "Context", "models.User.Name")
+}
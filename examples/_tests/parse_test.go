@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/switchupcb/copygen/cli/config"
+	"github.com/switchupcb/copygen/cli/parser"
+)
+
+// TestParseErrors tests whether the load and type errors of the setup file's package
+// and the packages it imports are reported separately.
+func TestParseErrors(t *testing.T) {
+	checkwd(t)
+
+	gen, err := config.LoadYML("_tests/testdata/load/setup/setup.yml")
+	if err != nil {
+		t.Fatalf("Parse(%q) error: %v", "load", err)
+	}
+
+	err = parser.Parse(gen)
+	if err == nil {
+		t.Fatalf("Parse(%q) expected an error for a setup file with type errors.", "load")
+	}
+
+	joined, ok := errors.Unwrap(err).(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 2 {
+		t.Fatalf("Parse(%q) got error %q, want a setup file error and a dependency error", "load", err)
+	}
+
+	wanted := []string{
+		"setup.go:11:18: undefined: models.Acount",
+		"model.go:7:8: undefined: Usr",
+	}
+
+	for i, want := range wanted {
+		if got := joined.Unwrap()[i].Error(); !strings.Contains(got, want) {
+			t.Fatalf("Parse(%q) got error %q, want error containing %q", "load", got, want)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Account represents a user account.
+type Account struct {
+	ID    int
+	Owner Usr
+}
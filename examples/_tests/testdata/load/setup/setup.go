@@ -0,0 +1,12 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/_tests/testdata/load/models"
+	"github.com/switchupcb/copygen/examples/main/domain"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	Basic(A *models.Acount, UserID string) *domain.Account
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/switchupcb/copygen/cli"
+)
+
+// watchTimeout represents the maximum duration that a watched example takes to generate code.
+const watchTimeout = 30 * time.Second
+
+// quietDuration represents a duration that is longer than a watcher waits to run again after a change.
+const quietDuration = 2 * time.Second
+
+// TestRunWatch tests a call to cli.RunWatch() using a copy of an example,
+// checking that code is generated again when its setup file is touched.
+func TestRunWatch(t *testing.T) {
+	checkwd(t)
+
+	ymlpath := copyExample(t, "basic")
+	dir := filepath.Dir(filepath.Dir(ymlpath))
+	setupdir := filepath.Dir(ymlpath)
+
+	env := cli.Environment{
+		YMLPath: ymlpath,
+		Write:   true,
+	}
+
+	stop := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- env.RunWatch(stop)
+	}()
+
+	valid, err := os.ReadFile("basic/copygen.go")
+	if err != nil {
+		t.Fatalf("error reading file in test %q.\n%v", "watch", err)
+	}
+
+	outpath := filepath.Join(dir, "copygen.go")
+	waitForOutput(t, outpath, valid)
+
+	// the output file isn't watched, so it isn't generated again after it's removed.
+	if err := os.Remove(outpath); err != nil {
+		t.Fatalf("error removing the output file.\n%v", err)
+	}
+
+	time.Sleep(quietDuration)
+	if _, err := os.Stat(outpath); !os.IsNotExist(err) {
+		t.Fatalf("RunWatch(%v) generated code again after the output file was removed", "watch")
+	}
+
+	// the output file is generated again after the setup file is touched once.
+	touched := time.Now().Add(time.Second)
+	if err := os.Chtimes(filepath.Join(setupdir, "setup.go"), touched, touched); err != nil {
+		t.Fatalf("error touching the setup file.\n%v", err)
+	}
+
+	waitForOutput(t, outpath, valid)
+
+	close(stop)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("RunWatch(%v) error: %v", "watch", err)
+		}
+
+	case <-time.After(watchTimeout):
+		t.Fatalf("RunWatch(%v) did not return after the stop channel was closed", "watch")
+	}
+}
+
+// waitForOutput waits until an output file is generated with the valid output.
+func waitForOutput(t *testing.T, outpath string, valid []byte) {
+	t.Helper()
+
+	deadline := time.Now().Add(watchTimeout)
+	for time.Now().Before(deadline) {
+		if code, err := os.ReadFile(outpath); err == nil && bytes.Equal(normalizeLineBreaks(code), normalizeLineBreaks(valid)) {
+			return
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	t.Fatalf("RunWatch(%v) did not generate an output equivalent to %v within %v", "watch", "basic/copygen.go", watchTimeout)
+}
@@ -0,0 +1,28 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+package assert
+
+// AssertHuman copies a Human to a Animal.
+func AssertHuman(tA Animal, fH Human) {
+	// Animal fields
+	tA = fH
+}
+
+// AssertPointer copies a *Human to a Animal.
+func AssertPointer(tA Animal, fH *Human) {
+	// Animal fields
+	tA = fH
+}
+
+// AssertInterface copies a Animal to a Human.
+func AssertInterface(tH Human, fA Animal) {
+	// Human fields
+	tH = fA.(Human)
+}
+
+// AssertInterfacePointer copies a Animal to a *Human.
+func AssertInterfacePointer(tH *Human, fA Animal) {
+	// *Human fields
+	tH = fA.(*Human)
+}
@@ -5,5 +5,5 @@ type Copygen interface {
 	AssertHuman(Human) Animal
 	AssertPointer(*Human) Animal
 	AssertInterface(Animal) Human
-	AssertInterfacePointer(*Animal) Human
+	AssertInterfacePointer(Animal) *Human
 }
@@ -24,3 +24,9 @@ func MapConvertPlaceholderWithExpression(ti int, fP Placeholder) {
 	// int fields
 	ti = fP.(int) + 5
 }
+
+// AutomatchConvertBool copies a bool to a Placeholder.
+func AutomatchConvertBool(tP Placeholder, fb bool) {
+	// Placeholder fields
+	tP = fb
+}
@@ -13,4 +13,7 @@ type Copygen interface {
 	// map convert.Placeholder int
 	// cast convert.Placeholder int + 5
 	MapConvertPlaceholderWithExpression(Placeholder) int
+
+	// automatch bool -cast
+	AutomatchConvertBool(bool) Placeholder
 }
@@ -0,0 +1,44 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+package depth
+
+// ZeroDepth copies a []string to a []string.
+func ZeroDepth(ts []string, fs []string) {
+	// []string fields
+	ts = fs
+}
+
+// DefaultDepth copies a []string to a One.
+func DefaultDepth(tO One, fs []string) {
+	// One fields
+	tO = One(fs)
+}
+
+// CustomDepth copies a []string to a Two.
+func CustomDepth(tT Two, fs []string) {
+	// Two fields
+	tT = Two(fs)
+}
+
+// ReverseDepth copies a Two to a []string.
+func ReverseDepth(ts []string, fT Two) {
+	// []string fields
+	ts = []string(fT)
+}
+
+// DisableCast copies a []string to a One.
+func DisableCast(tO One, fs []string) {
+	// One fields
+}
+
+// DefaultDepthID copies a AccountID to a UserID.
+func DefaultDepthID(tU UserID, fA AccountID) {
+	// UserID fields
+}
+
+// CustomDepthID copies a AccountID to a UserID.
+func CustomDepthID(tU UserID, fA AccountID) {
+	// UserID fields
+	tU = UserID(fA)
+}
@@ -2,3 +2,9 @@ package depth
 
 type One []string
 type Two One
+
+// AccountID represents an account ID, which is casted to a UserID at a depth-level of 2.
+type AccountID int
+
+// UserID represents a user ID.
+type UserID int
@@ -4,12 +4,17 @@ type Copygen interface {
 	ZeroDepth([]string) []string
 	DefaultDepth([]string) One
 
-	// cast .* .* 2
+	// cast .* depth.Two 2
 	CustomDepth([]string) Two
 
-	// cast .* .* 2
+	// cast .* []string 2
 	ReverseDepth(Two) []string
 
-	// cast .* .* 0
-	DisableCast([]string) []string
+	// cast .* depth.One 0
+	DisableCast([]string) One
+
+	DefaultDepthID(AccountID) UserID
+
+	// cast .* depth.UserID 2
+	CustomDepthID(AccountID) UserID
 }
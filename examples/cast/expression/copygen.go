@@ -0,0 +1,16 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+package expression
+
+// ExprDouble copies a int to a int.
+func ExprDouble(ti int, fi int) {
+	// int fields
+	ti = fi * 2
+}
+
+// MapExprXOR copies a bool to a bool.
+func MapExprXOR(tb bool, fb bool) {
+	// bool fields
+	tb = fb != true
+}
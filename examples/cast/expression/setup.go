@@ -5,6 +5,6 @@ type Copygen interface {
 	// cast int int * 2
 	ExprDouble(int) int
 
-	// map bool bool -cast ^ true
+	// map bool bool -cast != true
 	MapExprXOR(bool) bool
 }
@@ -0,0 +1,16 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+package function
+
+// TypeFuncString copies a Custom to a string.
+func TypeFuncString(ts string, fC Custom) {
+	// string fields
+	ts = fC.String()
+}
+
+// FuncString copies a Custom to a string.
+func FuncString(ts string, fC Custom) {
+	// string fields
+	ts = Convert(fC)
+}
@@ -2,9 +2,9 @@ package function
 
 // Copygen defines the functions that are generated.
 type Copygen interface {
-	// cast Custom string .String()
+	// cast function.Custom string .String()
 	TypeFuncString(Custom) string
 
-	// cast Custom string Convert()
+	// cast function.Custom string Convert()
 	FuncString(Custom) string
 }
@@ -0,0 +1,10 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+package property
+
+// PropertyFloat copies a Circle to a float32.
+func PropertyFloat(tf float32, fC Circle) {
+	// float32 fields
+	tf = fC.Radius
+}
@@ -0,0 +1,106 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/deepcopy/domain"
+	"github.com/switchupcb/copygen/examples/deepcopy/models"
+)
+
+// ModelsToDomain copies a *models.Account to a *domain.Account.
+func ModelsToDomain(tA *domain.Account, fA *models.Account) {
+	// *domain.Account fields
+	tA.ID = fA.ID
+	tA.Name = fA.Name
+	if fA.Emails != nil {
+		tA.Emails = make([]string, len(fA.Emails))
+		copy(tA.Emails, fA.Emails)
+	}
+	if fA.Settings != nil {
+		tA.Settings = make(map[string]bool, len(fA.Settings))
+		for k, v := range fA.Settings {
+			tA.Settings[k] = v
+		}
+	}
+	if fA.Owner != nil {
+		tA.Owner = new(models.User)
+		*tA.Owner = *fA.Owner
+		if fA.Owner.Nickname != nil {
+			tA.Owner.Nickname = new(string)
+			*tA.Owner.Nickname = *fA.Owner.Nickname
+		}
+	}
+	if fA.Users != nil {
+		tA.Users = make([]*models.User, len(fA.Users))
+		for i := range fA.Users {
+			if fA.Users[i] != nil {
+				tA.Users[i] = new(models.User)
+				*tA.Users[i] = *fA.Users[i]
+				if fA.Users[i].Nickname != nil {
+					tA.Users[i].Nickname = new(string)
+					*tA.Users[i].Nickname = *fA.Users[i].Nickname
+				}
+			}
+		}
+	}
+	if fA.Groups != nil {
+		tA.Groups = make(map[string][]*models.User, len(fA.Groups))
+		for k, v := range fA.Groups {
+			var c []*models.User
+			if v != nil {
+				c = make([]*models.User, len(v))
+				for i1 := range v {
+					if v[i1] != nil {
+						c[i1] = new(models.User)
+						*c[i1] = *v[i1]
+						if v[i1].Nickname != nil {
+							c[i1].Nickname = new(string)
+							*c[i1].Nickname = *v[i1].Nickname
+						}
+					}
+				}
+			}
+			tA.Groups[k] = c
+		}
+	}
+	tA.Scores = fA.Scores
+	for i := range fA.Scores {
+		if fA.Scores[i] != nil {
+			tA.Scores[i] = new(int)
+			*tA.Scores[i] = *fA.Scores[i]
+		}
+	}
+	tA.Address = fA.Address
+	if fA.Address.Lines != nil {
+		tA.Address.Lines = make([]string, len(fA.Address.Lines))
+		copy(tA.Address.Lines, fA.Address.Lines)
+	}
+}
+
+// ModelsToDomainUsers copies a *models.Account to a *domain.Account.
+func ModelsToDomainUsers(tA *domain.Account, fA *models.Account) {
+	// *domain.Account fields
+	tA.ID = fA.ID
+	tA.Name = fA.Name
+	tA.Emails = fA.Emails
+	tA.Settings = fA.Settings
+	tA.Owner = fA.Owner
+	if fA.Users != nil {
+		tA.Users = make([]*models.User, len(fA.Users))
+		for i := range fA.Users {
+			if fA.Users[i] != nil {
+				tA.Users[i] = new(models.User)
+				*tA.Users[i] = *fA.Users[i]
+				if fA.Users[i].Nickname != nil {
+					tA.Users[i].Nickname = new(string)
+					*tA.Users[i].Nickname = *fA.Users[i].Nickname
+				}
+			}
+		}
+	}
+	tA.Groups = fA.Groups
+	tA.Scores = fA.Scores
+	tA.Address = fA.Address
+}
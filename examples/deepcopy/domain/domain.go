@@ -0,0 +1,17 @@
+// Package domain contains business logic models.
+package domain
+
+import "github.com/switchupcb/copygen/examples/deepcopy/models"
+
+// Account represents a user account.
+type Account struct {
+	ID       int
+	Name     string
+	Emails   []string
+	Settings map[string]bool
+	Owner    *models.User
+	Users    []*models.User
+	Groups   map[string][]*models.User
+	Scores   [4]*int
+	Address  models.Address
+}
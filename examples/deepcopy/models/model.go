@@ -0,0 +1,28 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Account represents the data model for account.
+type Account struct {
+	ID       int
+	Name     string
+	Emails   []string
+	Settings map[string]bool
+	Owner    *User
+	Users    []*User
+	Groups   map[string][]*User
+	Scores   [4]*int
+	Address  Address
+}
+
+// A User represents the data model for a user.
+type User struct {
+	UserID   int
+	Name     string
+	Nickname *string
+}
+
+// Address represents the data model for an address.
+type Address struct {
+	Lines []string
+	Zip   string
+}
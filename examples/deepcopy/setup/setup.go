@@ -0,0 +1,16 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/deepcopy/domain"
+	"github.com/switchupcb/copygen/examples/deepcopy/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen interface {
+	// deepcopy .*
+	ModelsToDomain(*models.Account) *domain.Account
+
+	// deepcopy models.Account.Users
+	ModelsToDomainUsers(*models.Account) *domain.Account
+}
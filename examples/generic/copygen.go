@@ -0,0 +1,55 @@
+// Code generated by github.com/switchupcb/copygen
+// DO NOT EDIT.
+
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/generic/domain"
+	"github.com/switchupcb/copygen/examples/generic/models"
+)
+
+// PageToPage copies a *models.Page[A], func(A) B to a *domain.Page[B].
+func PageToPage[A any, B any](tP *domain.Page[B], fP *models.Page[A], ff func(A) B) {
+	// *domain.Page[B] fields
+	if fP.Items != nil {
+		tP.Items = make([]B, len(fP.Items))
+		for i := range fP.Items {
+			tP.Items[i] = ff(fP.Items[i])
+		}
+	}
+	if fP.Index != nil {
+		tP.Index = make(map[string]B, len(fP.Index))
+		for k, v := range fP.Index {
+			tP.Index[k] = ff(v)
+		}
+	}
+	tP.Cursor = ff(fP.Cursor)
+	tP.Total = fP.Total
+}
+
+// ResultToResult copies a *models.Result[T] to a *domain.Result[T].
+func ResultToResult[T comparable](tR *domain.Result[T], fR *models.Result[T]) {
+	// *domain.Result[T] fields
+	tR.Value = fR.Value
+	tR.Err = fR.Err
+}
+
+// ModelsToDomainUsers copies a *models.Page[models.User], func(models.User) domain.User to a *domain.Page[domain.User].
+func ModelsToDomainUsers(tP *domain.Page[domain.User], fP *models.Page[models.User], ff func(models.User) domain.User) {
+	// *domain.Page[domain.User] fields
+	if fP.Items != nil {
+		tP.Items = make([]domain.User, len(fP.Items))
+		for i := range fP.Items {
+			tP.Items[i] = ff(fP.Items[i])
+		}
+	}
+	if fP.Index != nil {
+		tP.Index = make(map[string]domain.User, len(fP.Index))
+		for k, v := range fP.Index {
+			tP.Index[k] = ff(v)
+		}
+	}
+	tP.Cursor = ff(fP.Cursor)
+	tP.Total = fP.Total
+}
@@ -0,0 +1,22 @@
+// Package domain contains business logic models.
+package domain
+
+// Page represents a page of items.
+type Page[T any] struct {
+	Items  []T
+	Index  map[string]T
+	Cursor T
+	Total  int
+}
+
+// Result represents the result of an operation.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// User represents a user.
+type User struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,22 @@
+// Package models contains data storage models (i.e database).
+package models
+
+// Page represents the data model for a page of items.
+type Page[T any] struct {
+	Items  []T
+	Index  map[string]T
+	Cursor T
+	Total  int
+}
+
+// Result represents the data model for the result of an operation.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// User represents the data model for a user.
+type User struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,14 @@
+// Package copygen contains the setup information for copygen generated code.
+package copygen
+
+import (
+	"github.com/switchupcb/copygen/examples/generic/domain"
+	"github.com/switchupcb/copygen/examples/generic/models"
+)
+
+// Copygen defines the functions that are generated.
+type Copygen[A any, B any, T comparable] interface {
+	PageToPage(*models.Page[A], func(A) B) *domain.Page[B]
+	ResultToResult(*models.Result[T]) *domain.Result[T]
+	ModelsToDomainUsers(*models.Page[models.User], func(models.User) domain.User) *domain.Page[domain.User]
+}
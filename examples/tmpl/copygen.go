@@ -7,8 +7,8 @@ package copygen
 import (
 	c "strconv"
 
-	"github.com/switchupcb/copygen/examples/main/domain"
-	"github.com/switchupcb/copygen/examples/main/models"
+	"github.com/switchupcb/copygen/examples/tmpl/domain"
+	"github.com/switchupcb/copygen/examples/tmpl/models"
 )
 
 /* Define the function and field this converter is applied to using regex. */
@@ -23,4 +23,4 @@ func ModelsToDomain(tA *domain.Account, fA *models.Account, fU *models.User) {
 	tA.ID = fA.ID
 	tA.UserID = Itoa(fU.UserID)
 	tA.Name = fA.Name
-}
\ No newline at end of file
+}